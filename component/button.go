@@ -17,32 +17,40 @@ type ButtonState struct {
 }
 
 type Button struct {
-	label string
-	style ButtonStyle
-	state ButtonState
+	label     string
+	theme     *Theme
+	style     ButtonStyle
+	overrides map[string]bool
+	state     ButtonState
 }
 
 var (
-	ButtonDefaultStyle = ButtonStyle{
+	ButtonDefaultStyle = NewButtonStyle(DefaultTheme)
+)
+
+func NewButtonStyle(theme Theme) ButtonStyle {
+	return ButtonStyle{
 		TextBase: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")).
+			Foreground(theme.Foreground).
 			Padding(0, 1),
 		TextFocus: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")).
+			Foreground(theme.AccentForeground).
 			Bold(true).
-			Background(lipgloss.Color("33")).
+			Background(theme.Accent).
 			Padding(0, 1),
 		TextActive: lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("33")).
+			Foreground(theme.Accent).
 			Padding(0, 1),
 	}
-)
+}
 
 func NewButton(label string) *Button {
 	m := &Button{
-		label: label,
-		style: ButtonDefaultStyle,
+		label:     label,
+		theme:     nil,
+		style:     ButtonDefaultStyle,
+		overrides: map[string]bool{},
 		state: ButtonState{
 			focus:  false,
 			active: false,
@@ -62,12 +70,24 @@ func (m *Button) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state.focus = typedMsg.Focus
 	case ActiveMsg:
 		m.state.active = typedMsg.Active
+	case ThemeMsg:
+		if m.theme == nil {
+			m.style = Restyle(m.style, NewButtonStyle(typedMsg.Theme), m.overrides)
+		}
 	}
 
 	return m, nil
 }
 
 func (m *Button) View() string {
+	if NoColor() && m.state.focus {
+		return m.style.TextFocus.UnsetPadding().Render("[" + m.label + "]")
+	}
+
+	if NoColor() && m.state.active {
+		return m.style.TextActive.UnsetPadding().Render("(" + m.label + ")")
+	}
+
 	if m.state.focus {
 		return m.style.TextFocus.Render(m.label)
 	}
@@ -79,26 +99,40 @@ func (m *Button) View() string {
 	return m.style.TextBase.Render(m.label)
 }
 
+func (m *Button) Label() string {
+	return m.label
+}
+
 func (m *Button) SetLabel(label string) *Button {
 	m.label = label
 
 	return m
 }
 
+func (m *Button) SetTheme(theme Theme) *Button {
+	m.theme = &theme
+	m.style = Restyle(m.style, NewButtonStyle(theme), m.overrides)
+
+	return m
+}
+
 func (m *Button) SetTextBaseStyle(style lipgloss.Style) *Button {
 	m.style.TextBase = style
+	m.overrides["TextBase"] = true
 
 	return m
 }
 
 func (m *Button) SetTextFocusStyle(style lipgloss.Style) *Button {
 	m.style.TextFocus = style
+	m.overrides["TextFocus"] = true
 
 	return m
 }
 
 func (m *Button) SetTextActiveStyle(style lipgloss.Style) *Button {
 	m.style.TextActive = style
+	m.overrides["TextActive"] = true
 
 	return m
 }
@@ -0,0 +1,100 @@
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MrSquaare/boba/internal/colortest"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestButtonColorProfiles(t *testing.T) {
+	for _, p := range colortest.Profiles {
+		t.Run(p.Name, func(t *testing.T) {
+			colortest.SetProfile(t, p.Profile, true)
+
+			base := NewButton("Yes")
+			focus := NewButton("Yes")
+			focus.Update(FocusMsg{Focus: true})
+			active := NewButton("Yes")
+			active.Update(ActiveMsg{Active: true})
+
+			for _, view := range []string{base.View(), focus.View(), active.View()} {
+				colortest.CheckProfile(t, p.Profile, view)
+			}
+
+			if focus.View() == base.View() {
+				t.Errorf("focused button is indistinguishable from base button: %q", base.View())
+			}
+
+			if active.View() == base.View() {
+				t.Errorf("active button is indistinguishable from base button: %q", base.View())
+			}
+
+			if p.Profile == termenv.Ascii && focus.View() != "[Yes]" {
+				t.Errorf("focused button = %q, want %q", focus.View(), "[Yes]")
+			}
+		})
+	}
+}
+
+func TestOptionColorProfiles(t *testing.T) {
+	for _, p := range colortest.Profiles {
+		t.Run(p.Name, func(t *testing.T) {
+			colortest.SetProfile(t, p.Profile, true)
+
+			base := NewOption("Vim")
+			focus := NewOption("Vim")
+			focus.Update(FocusMsg{Focus: true})
+			checked := NewOption("Vim")
+			checked.Update(CheckMsg{Checked: true})
+
+			for _, view := range []string{base.View(), focus.View(), checked.View()} {
+				colortest.CheckProfile(t, p.Profile, view)
+			}
+
+			if focus.View() == base.View() {
+				t.Errorf("focused option is indistinguishable from base option: %q", base.View())
+			}
+
+			if p.Profile == termenv.Ascii && strings.Contains(base.View(), ">") {
+				t.Errorf("base option shows the cursor under ascii profile: %q", base.View())
+			}
+
+			if !strings.Contains(checked.View(), "[x]") {
+				t.Errorf("checked option is missing its check mark: %q", checked.View())
+			}
+		})
+	}
+}
+
+func TestTextColorProfiles(t *testing.T) {
+	for _, p := range colortest.Profiles {
+		t.Run(p.Name, func(t *testing.T) {
+			colortest.SetProfile(t, p.Profile, true)
+
+			view := NewText("Hello").View()
+
+			colortest.CheckProfile(t, p.Profile, view)
+
+			if !strings.Contains(view, "Hello") {
+				t.Errorf("text view = %q, want it to contain %q", view, "Hello")
+			}
+		})
+	}
+}
+
+func TestAdaptiveColors(t *testing.T) {
+	colortest.SetProfile(t, termenv.TrueColor, true)
+
+	dark := NewText("Hello").View()
+
+	lipgloss.SetHasDarkBackground(false)
+
+	light := NewText("Hello").View()
+
+	if dark == light {
+		t.Errorf("text renders the same on light and dark backgrounds: %q", dark)
+	}
+}
@@ -1,6 +1,8 @@
 package component
 
 import (
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -16,57 +18,89 @@ type OptionStyle struct {
 	CursorBase   lipgloss.Style
 	CursorFocus  lipgloss.Style
 	CursorActive lipgloss.Style
+	CheckBase    lipgloss.Style
+	CheckChecked lipgloss.Style
+	TextMatch    lipgloss.Style
 }
 
 type OptionState struct {
-	focus  bool
-	active bool
+	focus     bool
+	active    bool
+	checkable bool
+	checked   bool
+	matches   []int
 }
 
 type Option struct {
-	label  string
-	cursor string
-	style  OptionStyle
-	state  OptionState
+	label        string
+	cursor       string
+	activeCursor string
+	checkBase    string
+	checkMark    string
+	theme        *Theme
+	style        OptionStyle
+	overrides    map[string]bool
+	state        OptionState
 }
 
 var (
-	OptionDefaultStyle = OptionStyle{
+	OptionDefaultStyle = NewOptionStyle(DefaultTheme)
+)
+
+func NewOptionStyle(theme Theme) OptionStyle {
+	return OptionStyle{
 		TextBase: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")).
+			Foreground(theme.Foreground).
 			MarginRight(1),
 		TextFocus: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")).
+			Foreground(theme.AccentForeground).
 			Bold(true).
-			Background(lipgloss.Color("33")).
+			Background(theme.Accent).
 			MarginRight(1),
 		TextActive: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("33")).
+			Foreground(theme.Accent).
 			Bold(true).
 			MarginRight(1),
 		CursorBase: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")).
+			Foreground(theme.Foreground).
 			PaddingRight(1),
 		CursorFocus: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")).
+			Foreground(theme.AccentForeground).
 			Bold(true).
-			Background(lipgloss.Color("33")).
+			Background(theme.Accent).
 			PaddingRight(1),
 		CursorActive: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("33")).
+			Foreground(theme.Accent).
 			Bold(true).
 			PaddingRight(1),
+		CheckBase: lipgloss.NewStyle().
+			Foreground(theme.Foreground).
+			PaddingRight(1),
+		CheckChecked: lipgloss.NewStyle().
+			Foreground(theme.Accent).
+			Bold(true).
+			PaddingRight(1),
+		TextMatch: lipgloss.NewStyle().
+			Underline(true),
 	}
-)
+}
 
 func NewOption(label string) *Option {
 	m := &Option{
-		label:  label,
-		cursor: ">",
-		style:  OptionDefaultStyle,
+		label:        label,
+		cursor:       ">",
+		activeCursor: "*",
+		checkBase:    "[ ]",
+		checkMark:    "[x]",
+		theme:        nil,
+		style:        OptionDefaultStyle,
+		overrides:    map[string]bool{},
 		state: OptionState{
-			focus:  false,
-			active: false,
+			focus:     false,
+			active:    false,
+			checkable: false,
+			checked:   false,
+			matches:   nil,
 		},
 	}
 
@@ -83,6 +117,15 @@ func (m *Option) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state.focus = typedMsg.Focus
 	case ActiveMsg:
 		m.state.active = typedMsg.Active
+	case CheckMsg:
+		m.state.checkable = true
+		m.state.checked = typedMsg.Checked
+	case MatchMsg:
+		m.state.matches = typedMsg.Indexes
+	case ThemeMsg:
+		if m.theme == nil {
+			m.style = Restyle(m.style, NewOptionStyle(typedMsg.Theme), m.overrides)
+		}
 	}
 
 	return m, nil
@@ -90,14 +133,26 @@ func (m *Option) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m *Option) View() string {
 	if m.state.focus {
-		return m.style.CursorFocus.Render(m.cursor) + m.style.TextFocus.Render(m.label)
+		return m.style.CursorFocus.Render(m.cursor) + m.checkView() + m.labelView(m.style.TextFocus)
+	}
+
+	if m.state.active && NoColor() {
+		return m.style.CursorActive.Render(m.activeCursor) + m.checkView() + m.labelView(m.style.TextActive)
 	}
 
 	if m.state.active {
-		return m.style.CursorActive.Render(m.cursor) + m.style.TextActive.Render(m.label)
+		return m.style.CursorActive.Render(m.cursor) + m.checkView() + m.labelView(m.style.TextActive)
 	}
 
-	return m.style.CursorBase.Render(m.cursor) + m.style.TextBase.Render(m.label)
+	if NoColor() {
+		return m.style.CursorBase.Render(strings.Repeat(" ", lipgloss.Width(m.cursor))) + m.checkView() + m.labelView(m.style.TextBase)
+	}
+
+	return m.style.CursorBase.Render(m.cursor) + m.checkView() + m.labelView(m.style.TextBase)
+}
+
+func (m *Option) Label() string {
+	return m.label
 }
 
 func (m *Option) SetLabel(label string) *Option {
@@ -112,38 +167,107 @@ func (m *Option) SetCursor(cursor string) *Option {
 	return m
 }
 
+func (m *Option) SetActiveCursor(cursor string) *Option {
+	m.activeCursor = cursor
+
+	return m
+}
+
+func (m *Option) SetCheck(base string, mark string) *Option {
+	m.checkBase = base
+	m.checkMark = mark
+
+	return m
+}
+
+func (m *Option) SetTheme(theme Theme) *Option {
+	m.theme = &theme
+	m.style = Restyle(m.style, NewOptionStyle(theme), m.overrides)
+
+	return m
+}
+
 func (m *Option) SetTextBaseStyle(style lipgloss.Style) *Option {
 	m.style.TextBase = style
+	m.overrides["TextBase"] = true
 
 	return m
 }
 
 func (m *Option) SetTextFocusStyle(style lipgloss.Style) *Option {
 	m.style.TextFocus = style
+	m.overrides["TextFocus"] = true
 
 	return m
 }
 
 func (m *Option) SetTextActiveStyle(style lipgloss.Style) *Option {
 	m.style.TextActive = style
+	m.overrides["TextActive"] = true
 
 	return m
 }
 
 func (m *Option) SetCursorBaseStyle(style lipgloss.Style) *Option {
 	m.style.CursorBase = style
+	m.overrides["CursorBase"] = true
 
 	return m
 }
 
 func (m *Option) SetCursorFocusStyle(style lipgloss.Style) *Option {
 	m.style.CursorFocus = style
+	m.overrides["CursorFocus"] = true
 
 	return m
 }
 
 func (m *Option) SetCursorActiveStyle(style lipgloss.Style) *Option {
 	m.style.CursorActive = style
+	m.overrides["CursorActive"] = true
+
+	return m
+}
+
+func (m *Option) SetCheckBaseStyle(style lipgloss.Style) *Option {
+	m.style.CheckBase = style
+	m.overrides["CheckBase"] = true
+
+	return m
+}
+
+func (m *Option) SetCheckCheckedStyle(style lipgloss.Style) *Option {
+	m.style.CheckChecked = style
+	m.overrides["CheckChecked"] = true
+
+	return m
+}
+
+func (m *Option) SetTextMatchStyle(style lipgloss.Style) *Option {
+	m.style.TextMatch = style
+	m.overrides["TextMatch"] = true
 
 	return m
 }
+
+func (m *Option) labelView(style lipgloss.Style) string {
+	if len(m.state.matches) == 0 {
+		return style.Render(m.label)
+	}
+
+	unmatched := style.UnsetMargins().UnsetPadding()
+
+	return style.Render(lipgloss.StyleRunes(m.label, m.state.matches, m.style.TextMatch.Inherit(unmatched), unmatched))
+}
+
+func (m *Option) checkView() string {
+	if !m.state.checkable {
+		return ""
+	}
+
+	if m.state.checked {
+		return m.style.CheckChecked.Render(m.checkMark)
+	}
+
+	return m.style.CheckBase.Render(m.checkBase)
+}
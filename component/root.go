@@ -2,6 +2,8 @@ package component
 
 import (
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
 type Component = tea.Model
@@ -10,6 +12,10 @@ type WithChild interface {
 	Child() Component
 }
 
+type WithLabel interface {
+	Label() string
+}
+
 type FocusMsg struct {
 	Focus bool
 }
@@ -17,3 +23,15 @@ type FocusMsg struct {
 type ActiveMsg struct {
 	Active bool
 }
+
+type CheckMsg struct {
+	Checked bool
+}
+
+type MatchMsg struct {
+	Indexes []int
+}
+
+func NoColor() bool {
+	return lipgloss.ColorProfile() == termenv.Ascii
+}
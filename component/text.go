@@ -10,21 +10,29 @@ type TextStyle struct {
 }
 
 type Text struct {
-	content string
-	style   TextStyle
+	content   string
+	theme     *Theme
+	style     TextStyle
+	overrides map[string]bool
 }
 
 var (
-	TextDefaultStyle = TextStyle{
+	TextDefaultStyle = NewTextStyle(DefaultTheme)
+)
+
+func NewTextStyle(theme Theme) TextStyle {
+	return TextStyle{
 		TextBase: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15")),
+			Foreground(theme.Foreground),
 	}
-)
+}
 
 func NewText(label string) *Text {
 	m := &Text{
-		content: label,
-		style:   TextDefaultStyle,
+		content:   label,
+		theme:     nil,
+		style:     TextDefaultStyle,
+		overrides: map[string]bool{},
 	}
 
 	return m
@@ -35,6 +43,13 @@ func (m *Text) Init() tea.Cmd {
 }
 
 func (m *Text) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch typedMsg := msg.(type) {
+	case ThemeMsg:
+		if m.theme == nil {
+			m.style = Restyle(m.style, NewTextStyle(typedMsg.Theme), m.overrides)
+		}
+	}
+
 	return m, nil
 }
 
@@ -48,8 +63,16 @@ func (m *Text) SetContent(content string) *Text {
 	return m
 }
 
+func (m *Text) SetTheme(theme Theme) *Text {
+	m.theme = &theme
+	m.style = Restyle(m.style, NewTextStyle(theme), m.overrides)
+
+	return m
+}
+
 func (m *Text) SetTextBaseStyle(style lipgloss.Style) *Text {
 	m.style.TextBase = style
+	m.overrides["TextBase"] = true
 
 	return m
 }
@@ -0,0 +1,177 @@
+package component
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Theme struct {
+	Foreground       lipgloss.AdaptiveColor
+	Muted            lipgloss.AdaptiveColor
+	Accent           lipgloss.AdaptiveColor
+	AccentForeground lipgloss.AdaptiveColor
+	Error            lipgloss.AdaptiveColor
+}
+
+type ThemeMsg struct {
+	Theme Theme
+}
+
+type themeColor lipgloss.AdaptiveColor
+
+type themeFile struct {
+	Base             string      `json:"base"`
+	Foreground       *themeColor `json:"foreground"`
+	Muted            *themeColor `json:"muted"`
+	Accent           *themeColor `json:"accent"`
+	AccentForeground *themeColor `json:"accentForeground"`
+	Error            *themeColor `json:"error"`
+}
+
+var (
+	DefaultTheme = Theme{
+		Foreground:       lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		Muted:            lipgloss.AdaptiveColor{Light: "243", Dark: "8"},
+		Accent:           lipgloss.AdaptiveColor{Light: "33", Dark: "33"},
+		AccentForeground: lipgloss.AdaptiveColor{Light: "15", Dark: "15"},
+		Error:            lipgloss.AdaptiveColor{Light: "1", Dark: "9"},
+	}
+	DraculaTheme = Theme{
+		Foreground:       lipgloss.AdaptiveColor{Light: "#1f1f1f", Dark: "#f8f8f2"},
+		Muted:            lipgloss.AdaptiveColor{Light: "#6c664b", Dark: "#6272a4"},
+		Accent:           lipgloss.AdaptiveColor{Light: "#644ac9", Dark: "#bd93f9"},
+		AccentForeground: lipgloss.AdaptiveColor{Light: "#fffbeb", Dark: "#282a36"},
+		Error:            lipgloss.AdaptiveColor{Light: "#cb3a2a", Dark: "#ff5555"},
+	}
+	CatppuccinTheme = Theme{
+		Foreground:       lipgloss.AdaptiveColor{Light: "#4c4f69", Dark: "#cdd6f4"},
+		Muted:            lipgloss.AdaptiveColor{Light: "#9ca0b0", Dark: "#6c7086"},
+		Accent:           lipgloss.AdaptiveColor{Light: "#8839ef", Dark: "#cba6f7"},
+		AccentForeground: lipgloss.AdaptiveColor{Light: "#eff1f5", Dark: "#1e1e2e"},
+		Error:            lipgloss.AdaptiveColor{Light: "#d20f39", Dark: "#f38ba8"},
+	}
+	Base16Theme = Theme{
+		Foreground:       lipgloss.AdaptiveColor{Light: "0", Dark: "7"},
+		Muted:            lipgloss.AdaptiveColor{Light: "8", Dark: "8"},
+		Accent:           lipgloss.AdaptiveColor{Light: "4", Dark: "4"},
+		AccentForeground: lipgloss.AdaptiveColor{Light: "15", Dark: "0"},
+		Error:            lipgloss.AdaptiveColor{Light: "1", Dark: "1"},
+	}
+	HighContrastTheme = Theme{
+		Foreground:       lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		Muted:            lipgloss.AdaptiveColor{Light: "8", Dark: "7"},
+		Accent:           lipgloss.AdaptiveColor{Light: "4", Dark: "11"},
+		AccentForeground: lipgloss.AdaptiveColor{Light: "15", Dark: "0"},
+		Error:            lipgloss.AdaptiveColor{Light: "1", Dark: "9"},
+	}
+	MonochromeTheme = Theme{
+		Foreground:       lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		Muted:            lipgloss.AdaptiveColor{Light: "8", Dark: "8"},
+		Accent:           lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		AccentForeground: lipgloss.AdaptiveColor{Light: "15", Dark: "0"},
+		Error:            lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+	}
+	Themes = map[string]Theme{
+		"default":       DefaultTheme,
+		"dracula":       DraculaTheme,
+		"catppuccin":    CatppuccinTheme,
+		"base16":        Base16Theme,
+		"high-contrast": HighContrastTheme,
+		"monochrome":    MonochromeTheme,
+	}
+)
+
+func ParseTheme(data []byte) (Theme, error) {
+	var file themeFile
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&file); err != nil {
+		return Theme{}, fmt.Errorf("theme: %w", err)
+	}
+
+	theme := DefaultTheme
+
+	if file.Base != "" {
+		base, ok := Themes[file.Base]
+		if !ok {
+			return Theme{}, fmt.Errorf("theme: unknown base %q", file.Base)
+		}
+
+		theme = base
+	}
+
+	for _, color := range []struct {
+		value  *themeColor
+		target *lipgloss.AdaptiveColor
+	}{
+		{file.Foreground, &theme.Foreground},
+		{file.Muted, &theme.Muted},
+		{file.Accent, &theme.Accent},
+		{file.AccentForeground, &theme.AccentForeground},
+		{file.Error, &theme.Error},
+	} {
+		if color.value != nil {
+			*color.target = lipgloss.AdaptiveColor(*color.value)
+		}
+	}
+
+	return theme, nil
+}
+
+func LoadTheme(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	theme, err := ParseTheme(data)
+	if err != nil {
+		return Theme{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return theme, nil
+}
+
+func (c *themeColor) UnmarshalJSON(data []byte) error {
+	var color string
+
+	if err := json.Unmarshal(data, &color); err == nil {
+		*c = themeColor{Light: color, Dark: color}
+
+		return nil
+	}
+
+	var adaptive struct {
+		Light string `json:"light"`
+		Dark  string `json:"dark"`
+	}
+
+	if err := json.Unmarshal(data, &adaptive); err != nil {
+		return errors.New("color must be a string or an object with light and dark keys")
+	}
+
+	*c = themeColor{Light: adaptive.Light, Dark: adaptive.Dark}
+
+	return nil
+}
+
+func Restyle[S any](style S, themed S, overrides map[string]bool) S {
+	current := reflect.ValueOf(&style).Elem()
+	next := reflect.ValueOf(themed)
+
+	for i := 0; i < current.NumField(); i++ {
+		if !overrides[current.Type().Field(i).Name] {
+			current.Field(i).Set(next.Field(i))
+		}
+	}
+
+	return style
+}
@@ -1,34 +1,37 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"net"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/MrSquaare/boba/component"
 	"github.com/MrSquaare/boba/form"
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/go-playground/validator/v10"
 )
 
-type Status = int
-
-const (
-	StatusForm Status = iota
-	StatusLoading
-	StatusSuccess
-	StatusError
-)
+type Config struct {
+	Host     string
+	Port     uint16
+	User     string
+	Auth     string
+	Key      string
+	Password string
+}
 
 type Model struct {
-	form    *form.Form
-	spinner spinner.Model
-	help    help.Model
-	status  Status
+	form *form.Form
+	help help.Model
 }
 
 type KeyMap struct {
@@ -36,8 +39,6 @@ type KeyMap struct {
 	Help key.Binding
 }
 
-type loadingMsg struct{}
-
 var (
 	headerStyle = lipgloss.NewStyle().
 			Foreground((lipgloss.Color("15"))).
@@ -65,6 +66,7 @@ func newModel() Model {
 			Component: form.NewField(
 				"Enter the server host",
 				form.NewInput().
+					SetSuggestionsFunc(getKnownHosts).
 					SetValidateFunc(func(s string) error {
 						validate := validator.New()
 
@@ -84,20 +86,10 @@ func newModel() Model {
 			Name: "port",
 			Component: form.NewField(
 				"Enter the server port",
-				form.NewInput().
-					SetValidateFunc(func(s string) error {
-						validate := validator.New()
-
-						if err := validate.Var(s, "required"); err != nil {
-							return fmt.Errorf("port is required")
-						}
-
-						if err := validate.Var(s, "number"); err != nil {
-							return fmt.Errorf("port must be a number")
-						}
-
-						return nil
-					}),
+				form.NewNumber().
+					SetMin(1).
+					SetMax(65535).
+					SetRequired(true),
 			),
 		},
 		{
@@ -113,6 +105,11 @@ func newModel() Model {
 						}
 
 						return nil
+					}).
+					SetAsyncValidateFunc(func(s string) tea.Cmd {
+						return func() tea.Msg {
+							return checkUser(s)
+						}
 					}),
 			),
 		},
@@ -138,24 +135,14 @@ func newModel() Model {
 			Component: form.NewHide(
 				form.NewField(
 					"Select the auth key",
-					form.NewLoader(
-						func() component.Component {
-							values := getKeyPaths()
-
-							options := make([]form.SelectItemProps, len(values))
-
-							for i, value := range values {
-								options[i] = form.SelectItemProps{
-									Value:     value,
-									Component: component.NewOption(value),
-								}
+					form.NewFilePicker().
+						SetDirectory("~/.ssh").
+						SetValidateFunc(func(value string) error {
+							if value == "" {
+								return fmt.Errorf("auth key is required")
 							}
 
-							return form.NewSelect(options)
-						},
-					).
-						SetBindings(func() any {
-							return myForm.Value("password")
+							return nil
 						}),
 				),
 			).
@@ -183,6 +170,7 @@ func newModel() Model {
 				form.NewField(
 					"Enter the auth password",
 					form.NewInput().
+						SetSecret(true).
 						SetValidateFunc(func(s string) error {
 							validate := validator.New()
 
@@ -200,81 +188,58 @@ func newModel() Model {
 		},
 	})
 
+	myForm.SetSubmitFunc(func(values map[string]any) error {
+		host, _ := values["host"].(string)
+		port, _ := values["port"].(int)
+		user, _ := values["user"].(string)
+		auth, _ := values["auth"].(string)
+		authKey, _ := values["key"].(string)
+		password, _ := values["password"].(string)
+
+		return connectToServer(Config{
+			Host:     host,
+			Port:     uint16(port),
+			User:     user,
+			Auth:     auth,
+			Key:      authKey,
+			Password: password,
+		})
+	})
+
 	m := Model{
-		form:    myForm,
-		spinner: spinner.New(),
-		help:    help.New(),
-		status:  StatusForm,
+		form: myForm,
+		help: help.New(),
 	}
 
 	return m
 }
 
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(m.form.Init(), m.spinner.Tick)
+	return m.form.Init()
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	cmds := []tea.Cmd{}
-
-	if m.status == StatusSuccess || m.status == StatusError {
-		return m, tea.Quit
-	}
-
 	switch typedMsg := msg.(type) {
+	case form.FormSubmittedMsg, form.FormCancelledMsg:
+		return m, tea.Quit
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(typedMsg, keyMap.Exit):
-			m.status = StatusError
+			m.form.Cancel()
 
 			return m, tea.Quit
-		}
-	}
-
-	switch m.status {
-	case StatusForm:
-		switch typedMsg := msg.(type) {
-		case tea.KeyMsg:
-			switch {
-			case key.Matches(typedMsg, keyMap.Help):
-				msg = nil
+		case key.Matches(typedMsg, keyMap.Help):
+			msg = nil
 
-				m.help.ShowAll = !m.help.ShowAll
-			}
+			m.help.ShowAll = !m.help.ShowAll
 		}
+	}
 
-		var cmd tea.Cmd
-
-		m.form, cmd = m.form.Update(msg)
-
-		cmds = append(cmds, cmd)
-
-		if m.form.Completed() {
-			m.status = StatusLoading
-
-			cmds = append(cmds, func() tea.Msg {
-				connectToServer()
-
-				return loadingMsg{}
-			})
-			cmds = append(cmds, m.spinner.Tick)
-		}
-	case StatusLoading:
-		switch msg.(type) {
-		case loadingMsg:
-			m.status = StatusSuccess
-
-			return m, tea.Quit
-		}
-
-		var cmd tea.Cmd
-
-		m.spinner, cmd = m.spinner.Update(msg)
+	var cmd tea.Cmd
 
-		cmds = append(cmds, cmd)
-	}
+	m.form, cmd = m.form.Update(msg)
 
-	return m, tea.Batch(cmds...)
+	return m, cmd
 }
 
 func (m Model) View() string {
@@ -282,24 +247,17 @@ func (m Model) View() string {
 
 	s += headerStyle.Render("examples: basic")
 
-	if m.status == StatusForm {
+	switch m.form.Status() {
+	case form.FormStatusSubmitted:
+		s += "\n\nSuccessfully connected to the server!"
+	case form.FormStatusCancelled:
+		s += "\n\nOperation cancelled."
+	default:
 		s += fmt.Sprintf("\n\n%s", m.form.View())
 
 		s += fmt.Sprintf("\n\n%s", m.help.View(m))
 	}
 
-	if m.status == StatusLoading {
-		s += fmt.Sprintf("\n\n%s", m.spinner.View())
-	}
-
-	if m.status == StatusSuccess {
-		s += "\n\nSuccessfully connected to the server!"
-	}
-
-	if m.status == StatusError {
-		s += "\n\nOperation cancelled."
-	}
-
 	s += "\n"
 
 	return s
@@ -316,24 +274,119 @@ func (m Model) FullHelp() [][]key.Binding {
 	}
 }
 
-func getKeyPaths() []string {
-	time.Sleep(1 * time.Second)
+func getKnownHosts() []string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
 
-	return []string{
-		"/path/to/key-1",
-		"/path/to/key-2",
-		"/path/to/key-3",
+	data, err := os.ReadFile(filepath.Join(home, ".ssh", "known_hosts"))
+	if err != nil {
+		return nil
 	}
+
+	hosts := []string{}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		for _, host := range strings.Split(fields[0], ",") {
+			host = strings.TrimPrefix(host, "[")
+			host, _, _ = strings.Cut(host, "]")
+
+			if net.ParseIP(host) != nil && !slices.Contains(hosts, host) {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	return hosts
+}
+
+func checkUser(user string) error {
+	time.Sleep(500 * time.Millisecond)
+
+	if user == "root" {
+		return fmt.Errorf("user root is not allowed")
+	}
+
+	return nil
 }
 
-func connectToServer() error {
+func connectToServer(config Config) error {
 	time.Sleep(1 * time.Second)
 
 	return nil
 }
 
 func main() {
-	if _, err := tea.NewProgram(newModel()).Run(); err != nil {
+	m := newModel()
+	myForm := m.form
+
+	myForm.DefineFlags(flag.CommandLine)
+
+	answersFile := flag.String("answers", "", "Path to a JSON file of answers")
+
+	flag.Parse()
+
+	answers := []form.Answers{}
+
+	if *answersFile != "" {
+		fileAnswers, err := form.LoadAnswers(*answersFile)
+		if err != nil {
+			fmt.Printf("could not load answers: %s\n", err)
+			os.Exit(1)
+		}
+
+		answers = append(answers, fileAnswers)
+	}
+
+	answers = append(answers, myForm.EnvAnswers("BOBA_"), myForm.FlagAnswers(flag.CommandLine))
+
+	if err := myForm.Prefill(answers...); err != nil {
+		fmt.Printf("ignoring answers: %s\n", err)
+	}
+
+	if !form.Interactive() {
+		if err := myForm.RunNonInteractive(); err != nil {
+			var answersErr *form.AnswersError
+
+			if errors.As(err, &answersErr) {
+				fmt.Printf("could not complete form: %s\n", answersErr)
+			} else {
+				fmt.Printf("could not connect to the server: %s\n", err)
+			}
+
+			os.Exit(1)
+		}
+
+		fmt.Println("Successfully connected to the server!")
+
+		return
+	}
+
+	if form.AccessibleMode() {
+
+		if err := myForm.RunAccessible(os.Stdin, os.Stdout); err != nil {
+			fmt.Printf("could not run form: %s\n", err)
+			os.Exit(1)
+		}
+
+		if !myForm.Completed() {
+			fmt.Println("Operation cancelled.")
+			os.Exit(1)
+		}
+
+		fmt.Println("Successfully connected to the server!")
+
+		return
+	}
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
 		fmt.Printf("could not start program: %s\n", err)
 		os.Exit(1)
 	}
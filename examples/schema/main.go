@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MrSquaare/boba/form"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Model struct {
+	form *form.Form
+	help help.Model
+}
+
+type KeyMap struct {
+	Exit key.Binding
+}
+
+var (
+	headerStyle = lipgloss.NewStyle().
+			Foreground((lipgloss.Color("15"))).
+			Bold(true).
+			Background(lipgloss.Color("33")).
+			Padding(0, 2)
+	keyMap = KeyMap{
+		Exit: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+			key.WithHelp("ctrl+c", "Exit"),
+		),
+	}
+)
+
+func (m Model) Init() tea.Cmd {
+	return m.form.Init()
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch typedMsg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(typedMsg, keyMap.Exit):
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.form, cmd = m.form.Update(msg)
+
+	if m.form.Completed() || m.form.Cancelled() {
+		return m, tea.Quit
+	}
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	var s string
+
+	s += headerStyle.Render("examples: schema")
+
+	if m.form.Status() == form.FormStatusEditing {
+		s += fmt.Sprintf("\n\n%s", m.form.View())
+
+		s += fmt.Sprintf("\n\n%s", m.help.ShortHelpView(append(m.form.Keys(), keyMap.Exit)))
+	}
+
+	s += "\n"
+
+	return s
+}
+
+func getKeyPaths() []form.SchemaOption {
+	time.Sleep(1 * time.Second)
+
+	return []form.SchemaOption{
+		{Value: "/path/to/key-1", Label: "/path/to/key-1"},
+		{Value: "/path/to/key-2", Label: "/path/to/key-2"},
+		{Value: "/path/to/key-3", Label: "/path/to/key-3"},
+	}
+}
+
+func main() {
+	path := "form.yaml"
+
+	if len(os.Args) > 1 {
+		path = os.Args[1]
+	}
+
+	schema, err := form.LoadSchema(path)
+	if err != nil {
+		fmt.Printf("could not load schema: %s\n", err)
+		os.Exit(1)
+	}
+
+	items, err := schema.
+		SetLoader("keys", getKeyPaths).
+		FormItems()
+	if err != nil {
+		fmt.Printf("could not build form: %s\n", err)
+		os.Exit(1)
+	}
+
+	model, err := tea.NewProgram(Model{form: form.NewForm(items), help: help.New()}).Run()
+	if err != nil {
+		fmt.Printf("could not start program: %s\n", err)
+		os.Exit(1)
+	}
+
+	if !model.(Model).form.Completed() {
+		fmt.Println("Operation cancelled.")
+		os.Exit(1)
+	}
+
+	fmt.Printf("%v\n", model.(Model).form.RedactedValues())
+}
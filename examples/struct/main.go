@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MrSquaare/boba/form"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Config struct {
+	Host string `boba:"label=Enter the server host,placeholder=127.0.0.1" validate:"required,ip"`
+	Port int    `boba:"label=Enter the server port" validate:"min=1,max=65535"`
+	User string `boba:"label=Enter the auth user" validate:"required"`
+	Auth string `boba:"label=Select an auth method,options=key:Key|password:Password"`
+}
+
+type Model struct {
+	form *form.Form
+	help help.Model
+}
+
+type KeyMap struct {
+	Exit key.Binding
+}
+
+var (
+	headerStyle = lipgloss.NewStyle().
+			Foreground((lipgloss.Color("15"))).
+			Bold(true).
+			Background(lipgloss.Color("33")).
+			Padding(0, 2)
+	keyMap = KeyMap{
+		Exit: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+			key.WithHelp("ctrl+c", "Exit"),
+		),
+	}
+)
+
+func (m Model) Init() tea.Cmd {
+	return m.form.Init()
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch typedMsg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(typedMsg, keyMap.Exit):
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.form, cmd = m.form.Update(msg)
+
+	if m.form.Completed() || m.form.Cancelled() {
+		return m, tea.Quit
+	}
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	var s string
+
+	s += headerStyle.Render("examples: struct")
+
+	if m.form.Status() == form.FormStatusEditing {
+		s += fmt.Sprintf("\n\n%s", m.form.View())
+
+		s += fmt.Sprintf("\n\n%s", m.help.ShortHelpView(append(m.form.Keys(), keyMap.Exit)))
+	}
+
+	s += "\n"
+
+	return s
+}
+
+func main() {
+	config := Config{
+		Port: 22,
+		Auth: "key",
+	}
+
+	myForm, err := form.FromStruct(&config)
+	if err != nil {
+		fmt.Printf("could not build form: %s\n", err)
+		os.Exit(1)
+	}
+
+	model, err := tea.NewProgram(Model{form: myForm, help: help.New()}).Run()
+	if err != nil {
+		fmt.Printf("could not start program: %s\n", err)
+		os.Exit(1)
+	}
+
+	if !model.(Model).form.Completed() {
+		fmt.Println("Operation cancelled.")
+		os.Exit(1)
+	}
+
+	fmt.Printf("%+v\n", config)
+}
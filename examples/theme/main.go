@@ -0,0 +1,141 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/MrSquaare/boba/form"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Model struct {
+	form  *form.Form
+	help  help.Model
+	theme component.Theme
+}
+
+type KeyMap struct {
+	Exit key.Binding
+}
+
+var (
+	keyMap = KeyMap{
+		Exit: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+			key.WithHelp("ctrl+c", "Exit"),
+		),
+	}
+)
+
+func (m Model) Init() tea.Cmd {
+	return m.form.Init()
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch typedMsg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(typedMsg, keyMap.Exit):
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.form, cmd = m.form.Update(msg)
+
+	if m.form.Completed() || m.form.Cancelled() {
+		return m, tea.Quit
+	}
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	var s string
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(m.theme.AccentForeground).
+		Bold(true).
+		Background(m.theme.Accent).
+		Padding(0, 2)
+
+	s += headerStyle.Render("examples: theme")
+
+	if m.form.Status() == form.FormStatusEditing {
+		s += fmt.Sprintf("\n\n%s", m.form.View())
+
+		s += fmt.Sprintf("\n\n%s", m.help.ShortHelpView(append(m.form.Keys(), keyMap.Exit)))
+	}
+
+	s += "\n"
+
+	return s
+}
+
+func main() {
+	themes := []string{}
+
+	for name := range component.Themes {
+		themes = append(themes, name)
+	}
+
+	slices.Sort(themes)
+
+	name := flag.String("theme", "default", fmt.Sprintf("theme preset (%s)", strings.Join(themes, ", ")))
+	file := flag.String("file", "", "load the theme from a JSON file")
+
+	flag.Parse()
+
+	theme, ok := component.Themes[*name]
+	if !ok {
+		fmt.Printf("unknown theme %q\n", *name)
+		os.Exit(1)
+	}
+
+	if *file != "" {
+		var err error
+
+		theme, err = component.LoadTheme(*file)
+		if err != nil {
+			fmt.Printf("could not load theme: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	myForm := form.NewForm([]form.FormItem{
+		{Name: "name", Component: form.NewField("Enter your name", form.NewInput().SetPlaceholder("Jane Doe"))},
+		{Name: "editor", Component: form.NewField("Select your editor", form.NewSelect([]form.SelectItemProps{
+			{Value: "vim", Component: component.NewOption("Vim")},
+			{Value: "emacs", Component: component.NewOption("Emacs")},
+			{Value: "nano", Component: component.NewOption("Nano")},
+		}))},
+		{Name: "languages", Component: form.NewField("Select your languages", form.NewMultiSelect([]form.SelectItemProps{
+			{Value: "go", Component: component.NewOption("Go")},
+			{Value: "rust", Component: component.NewOption("Rust")},
+			{Value: "zig", Component: component.NewOption("Zig")},
+		}))},
+		{Name: "since", Component: form.NewField("When did you start?", form.NewDatePicker())},
+		{Name: "confirm", Component: form.NewField("Save these preferences?", form.NewConfirm())},
+	}).SetTheme(theme)
+
+	model, err := tea.NewProgram(Model{form: myForm, help: help.New(), theme: theme}).Run()
+	if err != nil {
+		fmt.Printf("could not start program: %s\n", err)
+		os.Exit(1)
+	}
+
+	if !model.(Model).form.Completed() {
+		fmt.Println("Operation cancelled.")
+		os.Exit(1)
+	}
+
+	fmt.Printf("%+v\n", model.(Model).form.Values())
+}
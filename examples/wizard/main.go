@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/MrSquaare/boba/form"
+)
+
+func main() {
+	myForm := form.NewGroupedForm([]form.Group{
+		{
+			Title:       "Account",
+			Description: "Tell us who you are",
+			Items: []form.FormItem{
+				{Name: "name", Component: form.NewField("Enter your name", form.NewInput())},
+				{Name: "email", Component: form.NewField("Enter your email", form.NewInput())},
+			},
+		},
+		{
+			Title:       "Preferences",
+			Description: "Choose how you want to use the app",
+			Items: []form.FormItem{
+				{Name: "theme", Component: form.NewField("Select a theme", form.NewSelect([]form.SelectItemProps{
+					{Value: "light", Component: component.NewButton("Light")},
+					{Value: "dark", Component: component.NewButton("Dark")},
+				}).SetInline(true))},
+				{Name: "newsletter", Component: form.NewField("Subscribe to the newsletter?", form.NewConfirm())},
+			},
+		},
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if _, err := form.Run(ctx, myForm, form.WithTitle("examples: wizard")); err != nil {
+		if errors.Is(err, form.ErrAborted) {
+			fmt.Println("Operation cancelled.")
+		} else {
+			fmt.Printf("could not run form: %s\n", err)
+		}
+
+		os.Exit(1)
+	}
+}
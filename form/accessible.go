@@ -0,0 +1,465 @@
+package form
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+	"github.com/muesli/termenv"
+)
+
+type accessiblePrompt struct {
+	ctx    context.Context
+	input  io.Reader
+	reader *bufio.Reader
+	writer io.Writer
+}
+
+func AccessibleMode() bool {
+	return os.Getenv("TERM") == "dumb" || os.Getenv("ACCESSIBLE") != ""
+}
+
+func (m *Form) RunAccessible(r io.Reader, w io.Writer) error {
+	return m.runAccessible(context.Background(), r, w)
+}
+
+func (m *Form) runAccessible(ctx context.Context, r io.Reader, w io.Writer) error {
+	profile := lipgloss.ColorProfile()
+
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(profile)
+
+	p := accessiblePrompt{ctx: ctx, input: r, reader: bufio.NewReader(r), writer: w}
+	page := -1
+	from := 0
+
+	m.updateItems()
+
+	for m.state.status == FormStatusEditing {
+		index := m.state.selectedIndex
+
+		if m.groups != nil && m.group(index) != page {
+			page = m.group(index)
+
+			p.printf("\n%s\n", m.groupView())
+		}
+
+		m.notes(p, from, index)
+
+		if err := m.ask(p, m.items[index]); err != nil {
+			if errors.Is(err, io.EOF) {
+				m.Cancel()
+
+				return nil
+			}
+
+			return err
+		}
+
+		from = m.start(index)
+
+		m.accessibleNext()
+
+		if m.state.status == FormStatusEditing && m.state.selectedIndex <= index {
+			from = m.state.selectedIndex
+
+			if err := m.Error(m.items[m.state.selectedIndex].Name); err != nil {
+				p.printf("Error: %s\n", err)
+			}
+
+			if m.state.submitErr != nil {
+				p.printf("Error: %s\n", m.state.submitErr)
+			}
+		}
+	}
+
+	if m.state.status == FormStatusSubmitted {
+		m.notes(p, from, len(m.items))
+	}
+
+	return nil
+}
+
+func (m *Form) accessibleNext() {
+	cmd := m.next()
+
+	for m.state.validating {
+		for _, msg := range messages(cmd) {
+			m.items[m.state.selectedIndex].Component, _ = m.items[m.state.selectedIndex].Component.Update(msg)
+		}
+
+		m.state.validating = false
+
+		cmd = m.next()
+	}
+
+	if m.state.status == FormStatusSubmitting {
+		for _, msg := range messages(cmd) {
+			m.Update(msg)
+		}
+	}
+
+	m.updateItems()
+}
+
+func (m *Form) notes(p accessiblePrompt, from int, to int) {
+	for i := from; i < to; i++ {
+		item := m.items[i]
+
+		if withHide, ok := withHide(item.Component); ok && withHide.Hide() {
+			continue
+		}
+
+		if isSkip(item.Component) {
+			p.printf("%s\n", item.Component.View())
+		}
+	}
+}
+
+func (m *Form) ask(p accessiblePrompt, item FormItem) error {
+	label, c := leaf(item)
+
+	switch typed := c.(type) {
+	case *Input:
+		return p.askInput(label, typed)
+	case *Number:
+		return p.askInput(label, typed.Input())
+	case *TextArea:
+		return p.askTextArea(label, typed)
+	case *Select:
+		return p.askSelect(label, typed)
+	case *MultiSelect:
+		return p.askMultiSelect(label, typed)
+	case *Confirm:
+		return p.askConfirm(label, typed)
+	case *DatePicker:
+		return p.askDatePicker(label, typed)
+	case *FilePicker:
+		return p.askFilePicker(label, typed)
+	}
+
+	p.printf("%s\n%s\n", label, c.View())
+
+	_, err := p.readLine("Press enter to continue: ")
+
+	return err
+}
+
+func (p accessiblePrompt) askInput(label string, m *Input) error {
+	hint := ""
+
+	if m.formatMask != "" {
+		hint = fmt.Sprintf(" (format %s)", m.formatMask)
+	}
+
+	prompt := label + hint + p.current(m.Value(), !m.secret) + p.clear(m.Value()) + ": "
+
+	var answer string
+	var err error
+
+	if m.secret {
+		answer, err = p.readSecret(prompt)
+	} else {
+		answer, err = p.readLine(prompt)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch answer {
+	case "":
+	case "-":
+		m.SetValue("")
+	default:
+		m.SetValue(answer)
+	}
+
+	return nil
+}
+
+func (p accessiblePrompt) askTextArea(label string, m *TextArea) error {
+	p.printf("%s (finish with a line containing only \".\")\n", label)
+
+	lines := []string{}
+
+	for {
+		line, err := p.readLine("")
+		if err != nil && (len(lines) == 0 || !errors.Is(err, io.EOF)) {
+			return err
+		}
+
+		if line == "." || err != nil {
+			break
+		}
+
+		lines = append(lines, line)
+	}
+
+	if len(lines) > 0 {
+		m.SetValue(strings.Join(lines, "\n"))
+	}
+
+	return nil
+}
+
+func (p accessiblePrompt) askSelect(label string, m *Select) error {
+	p.printf("%s\n", label)
+
+	for i, item := range m.items {
+		p.printf("  %d) %s\n", i+1, itemLabel(item))
+	}
+
+	for {
+		answer, err := p.readLine(fmt.Sprintf("Choose 1-%d%s: ", len(m.items), p.current(strconv.Itoa(m.state.selectedIndex+1), true)))
+		if err != nil {
+			return err
+		}
+
+		if answer == "" {
+			return nil
+		}
+
+		choice, err := strconv.Atoi(answer)
+		if err != nil || choice < 1 || choice > len(m.items) {
+			p.printf("Error: enter a number between 1 and %d\n", len(m.items))
+
+			continue
+		}
+
+		m.SetSelectedIndex(choice - 1)
+
+		return nil
+	}
+}
+
+func (p accessiblePrompt) askMultiSelect(label string, m *MultiSelect) error {
+	p.printf("%s\n", label)
+
+	checked := []string{}
+
+	for i, item := range m.items {
+		mark := " "
+
+		if m.state.checked[i] {
+			mark = "x"
+			checked = append(checked, strconv.Itoa(i+1))
+		}
+
+		p.printf("  %d) [%s] %s\n", i+1, mark, itemLabel(item))
+	}
+
+	for {
+		answer, err := p.readLine(fmt.Sprintf("Choose numbers separated by commas, or \"none\"%s: ", p.current(strings.Join(checked, ","), len(checked) > 0)))
+		if err != nil {
+			return err
+		}
+
+		if answer == "" {
+			return nil
+		}
+
+		choices := []int{}
+
+		if answer != "none" {
+			for _, field := range strings.Split(answer, ",") {
+				choice, err := strconv.Atoi(strings.TrimSpace(field))
+				if err != nil || choice < 1 || choice > len(m.items) {
+					choices = nil
+
+					break
+				}
+
+				choices = append(choices, choice-1)
+			}
+
+			if choices == nil {
+				p.printf("Error: enter numbers between 1 and %d\n", len(m.items))
+
+				continue
+			}
+		}
+
+		m.SelectNone()
+
+		for i := range m.items {
+			m.SetChecked(i, slices.Contains(choices, i))
+		}
+
+		return nil
+	}
+}
+
+func (p accessiblePrompt) askConfirm(label string, m *Confirm) error {
+	options := "y/N"
+
+	if m.Confirmed() {
+		options = "Y/n"
+	}
+
+	for {
+		answer, err := p.readLine(fmt.Sprintf("%s [%s]: ", label, options))
+		if err != nil {
+			return err
+		}
+
+		switch strings.ToLower(answer) {
+		case "":
+			return nil
+		case "y", "yes", strings.ToLower(m.Affirmative().Label()):
+			m.SetValue(true)
+
+			return nil
+		case "n", "no", strings.ToLower(m.Negative().Label()):
+			m.SetValue(false)
+
+			return nil
+		}
+
+		p.printf("Error: answer yes or no\n")
+	}
+}
+
+func (p accessiblePrompt) askDatePicker(label string, m *DatePicker) error {
+	for {
+		answer, err := p.readLine(fmt.Sprintf("%s (format %s)%s: ", label, m.Layout(), p.current(m.Value(), true)))
+		if err != nil {
+			return err
+		}
+
+		if answer == "" {
+			return nil
+		}
+
+		value, err := time.ParseInLocation(m.Layout(), answer, m.Time().Location())
+		if err != nil {
+			p.printf("Error: enter a date in the format %s\n", m.Layout())
+
+			continue
+		}
+
+		m.state.value = value
+
+		return nil
+	}
+}
+
+func (p accessiblePrompt) askFilePicker(label string, m *FilePicker) error {
+	answer, err := p.readLine(label + p.current(m.Value(), true) + p.clear(m.Value()) + ": ")
+	if err != nil {
+		return err
+	}
+
+	switch answer {
+	case "":
+	case "-":
+		m.SetValue("")
+	default:
+		m.SetValue(expandPath(answer))
+	}
+
+	return nil
+}
+
+func (p accessiblePrompt) current(value string, show bool) string {
+	if value == "" || !show {
+		return ""
+	}
+
+	return fmt.Sprintf(" [%s]", value)
+}
+
+func (p accessiblePrompt) clear(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return " (- to clear)"
+}
+
+func (p accessiblePrompt) readSecret(prompt string) (string, error) {
+	if !isTerminal(p.input) {
+		return p.readLine(prompt)
+	}
+
+	p.printf("%s", prompt)
+
+	secret, err := p.wait(func() (string, error) {
+		secret, err := term.ReadPassword(p.input.(interface{ Fd() uintptr }).Fd())
+
+		return string(secret), err
+	})
+
+	p.printf("\n")
+
+	return secret, err
+}
+
+func (p accessiblePrompt) readLine(prompt string) (string, error) {
+	p.printf("%s", prompt)
+
+	return p.wait(func() (string, error) {
+		line, err := p.reader.ReadString('\n')
+		if err != nil && (line == "" || !errors.Is(err, io.EOF)) {
+			return "", err
+		}
+
+		return strings.TrimRight(line, "\r\n"), nil
+	})
+}
+
+func (p accessiblePrompt) wait(read func() (string, error)) (string, error) {
+	type result struct {
+		line string
+		err  error
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		line, err := read()
+
+		done <- result{line: line, err: err}
+	}()
+
+	select {
+	case <-p.ctx.Done():
+		return "", p.ctx.Err()
+	case r := <-done:
+		return r.line, r.err
+	}
+}
+
+func (p accessiblePrompt) printf(format string, args ...any) {
+	fmt.Fprintf(p.writer, format, args...)
+}
+
+func messages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	msg := cmd()
+
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		msgs := []tea.Msg{}
+
+		for _, cmd := range batch {
+			msgs = append(msgs, messages(cmd)...)
+		}
+
+		return msgs
+	}
+
+	return []tea.Msg{msg}
+}
@@ -0,0 +1,465 @@
+package form
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/x/term"
+)
+
+type Answers map[string]string
+
+type AnswersError struct {
+	Missing []string
+	Invalid map[string]error
+}
+
+func (e *AnswersError) Error() string {
+	messages := []string{}
+
+	if len(e.Missing) > 0 {
+		messages = append(messages, fmt.Sprintf("missing answers: %s", strings.Join(e.Missing, ", ")))
+	}
+
+	names := make([]string, 0, len(e.Invalid))
+
+	for name := range e.Invalid {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("invalid answer for %s: %s", name, e.Invalid[name]))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func Interactive() bool {
+	return isTerminal(os.Stdin) && isTerminal(os.Stdout)
+}
+
+func LoadAnswers(path string) (Answers, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseAnswers(data)
+}
+
+func ParseAnswers(data []byte) (Answers, error) {
+	var raw map[string]any
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	answers := Answers{}
+
+	for name, value := range raw {
+		if value == nil {
+			continue
+		}
+
+		answer, err := answerString(value)
+		if err != nil {
+			return nil, fmt.Errorf("answer %s: %w", name, err)
+		}
+
+		answers[name] = answer
+	}
+
+	return answers, nil
+}
+
+func (m *Form) EnvAnswers(prefix string) Answers {
+	answers := Answers{}
+	replacer := strings.NewReplacer("-", "_", ".", "_", " ", "_")
+
+	for _, item := range m.items {
+		if value, ok := os.LookupEnv(prefix + strings.ToUpper(replacer.Replace(item.Name))); ok {
+			answers[item.Name] = value
+		}
+	}
+
+	return answers
+}
+
+func (m *Form) DefineFlags(fs *flag.FlagSet) *Form {
+	for _, item := range m.items {
+		if _, ok := withSkip(item.Component); ok || fs.Lookup(item.Name) != nil {
+			continue
+		}
+
+		label, _ := leaf(item)
+
+		fs.String(item.Name, "", label)
+	}
+
+	return m
+}
+
+func (m *Form) FlagAnswers(fs *flag.FlagSet) Answers {
+	answers := Answers{}
+
+	fs.Visit(func(f *flag.Flag) {
+		for _, item := range m.items {
+			if item.Name == f.Name {
+				answers[item.Name] = f.Value.String()
+			}
+		}
+	})
+
+	return answers
+}
+
+func (m *Form) Prefill(answers ...Answers) error {
+	merged := Answers{}
+
+	for _, answers := range answers {
+		for name, answer := range answers {
+			merged[name] = answer
+		}
+	}
+
+	invalid := map[string]error{}
+
+	for name, answer := range merged {
+		index := slices.IndexFunc(m.items, func(item FormItem) bool {
+			return item.Name == name
+		})
+
+		if index < 0 {
+			invalid[name] = errors.New("unknown item")
+
+			continue
+		}
+
+		delete(m.state.prefilled, name)
+
+		_, c := leaf(m.items[index])
+
+		if err := setAnswer(c, answer); err != nil {
+			invalid[name] = err
+
+			continue
+		}
+
+		if err := validateNow(m.items[index].Component); err != nil {
+			invalid[name] = err
+
+			continue
+		}
+
+		m.state.prefilled[name] = true
+	}
+
+	if len(m.state.path) == 1 && m.state.prefilled[m.items[m.state.selectedIndex].Name] {
+		if following, passed := m.forward(m.state.selectedIndex); following < len(m.items) {
+			for _, index := range passed {
+				m.advance(index)
+			}
+
+			m.advance(following)
+		}
+
+		if m.state.step < m.state.selectedIndex {
+			m.state.step = m.state.selectedIndex
+		}
+	}
+
+	m.updateItems()
+
+	if len(invalid) > 0 {
+		return &AnswersError{Missing: nil, Invalid: invalid}
+	}
+
+	return nil
+}
+
+func (m *Form) Prefilled(name string) bool {
+	return m.state.prefilled[name]
+}
+
+func (m *Form) Missing() []string {
+	missing := []string{}
+
+	for _, index := range m.walk() {
+		item := m.items[index]
+
+		if m.state.prefilled[item.Name] || !blank(item.Component) {
+			continue
+		}
+
+		missing = append(missing, item.Name)
+	}
+
+	return missing
+}
+
+func (m *Form) RunNonInteractive() error {
+	return m.runNonInteractive(context.Background())
+}
+
+func (m *Form) runNonInteractive(ctx context.Context) error {
+	walk := m.walk()
+	err := &AnswersError{Missing: []string{}, Invalid: map[string]error{}}
+
+	for _, index := range walk {
+		item := m.items[index]
+
+		if branchErr := m.branchError(index); branchErr != nil {
+			err.Invalid[item.Name] = branchErr
+		}
+
+		if m.state.prefilled[item.Name] {
+			continue
+		}
+
+		if validationErr := validateNow(item.Component); validationErr != nil {
+			if blank(item.Component) {
+				err.Missing = append(err.Missing, item.Name)
+			} else {
+				err.Invalid[item.Name] = validationErr
+			}
+		}
+	}
+
+	if len(walk) > 0 {
+		m.state.path = walk
+		m.state.position = len(walk) - 1
+		m.state.selectedIndex = walk[len(walk)-1]
+		m.state.step = m.state.selectedIndex
+	}
+
+	if len(err.Missing) == 0 && len(err.Invalid) == 0 && !m.validate(len(m.items)-1) {
+		for name, validationErr := range m.state.errors {
+			err.Invalid[name] = validationErr
+		}
+	}
+
+	if len(err.Missing) > 0 || len(err.Invalid) > 0 {
+		return err
+	}
+
+	if m.submitFunc != nil {
+		done := make(chan error, 1)
+		values := m.Values()
+		submitFunc := m.submitFunc
+
+		go func() {
+			done <- submitFunc(values)
+		}()
+
+		var submitErr error
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case submitErr = <-done:
+		}
+
+		if submitErr != nil {
+			m.state.submitErr = submitErr
+
+			return submitErr
+		}
+	}
+
+	if decodeErr := m.decodeTarget(); decodeErr != nil {
+		m.state.submitErr = decodeErr
+
+		return decodeErr
+	}
+
+	m.state.status = FormStatusSubmitted
+	m.state.submitErr = nil
+
+	return nil
+}
+
+func (m *Form) walk() []int {
+	walk := []int{}
+
+	for i := 0; i < len(m.items); {
+		if isSkip(m.items[i].Component) {
+			i++
+
+			continue
+		}
+
+		walk = append(walk, i)
+		i = m.start(i)
+	}
+
+	return walk
+}
+
+func leaf(item FormItem) (string, component.Component) {
+	label := item.Name
+	c := item.Component
+
+	for {
+		if field, ok := c.(*Field); ok && label == item.Name {
+			label = field.label
+		}
+
+		if loader, ok := c.(*Loader); ok {
+			loader.load()
+		}
+
+		withChild, ok := c.(component.WithChild)
+		if !ok || withChild.Child() == nil {
+			return label, c
+		}
+
+		c = withChild.Child()
+	}
+}
+
+func setAnswer(c component.Component, answer string) error {
+	switch typed := c.(type) {
+	case *Input:
+		typed.SetValue(answer)
+	case *Number:
+		typed.Input().SetValue(answer)
+	case *TextArea:
+		typed.SetValue(answer)
+	case *Select:
+		for i, item := range typed.items {
+			if item.Value == answer || strings.EqualFold(itemLabel(item), answer) {
+				typed.SetSelectedIndex(i)
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%q is not one of the options", answer)
+	case *MultiSelect:
+		values := []string{}
+
+		for _, field := range strings.Split(answer, ",") {
+			if field = strings.TrimSpace(field); field == "" {
+				continue
+			}
+
+			index := slices.IndexFunc(typed.items, func(item SelectItemProps) bool {
+				return item.Value == field || strings.EqualFold(itemLabel(item), field)
+			})
+
+			if index < 0 {
+				return fmt.Errorf("%q is not one of the options", field)
+			}
+
+			values = append(values, typed.items[index].Value)
+		}
+
+		typed.SetValues(values)
+	case *Confirm:
+		switch strings.ToLower(answer) {
+		case "y", "yes", strings.ToLower(typed.Affirmative().Label()):
+			typed.SetValue(true)
+		case "n", "no", strings.ToLower(typed.Negative().Label()):
+			typed.SetValue(false)
+		default:
+			value, err := strconv.ParseBool(answer)
+			if err != nil {
+				return fmt.Errorf("%q is not yes or no", answer)
+			}
+
+			typed.SetValue(value)
+		}
+	case *DatePicker:
+		value, err := time.ParseInLocation(typed.Layout(), answer, typed.Time().Location())
+		if err != nil {
+			return fmt.Errorf("%q is not in the format %s", answer, typed.Layout())
+		}
+
+		typed.state.value = value
+	case *FilePicker:
+		typed.SetValue(expandPath(answer))
+	default:
+		return errors.New("item does not accept answers")
+	}
+
+	return nil
+}
+
+func validateNow(c component.Component) error {
+	withValidation, ok := withValidation(c)
+	if !ok {
+		return nil
+	}
+
+	if !withValidation.Validate() {
+		return withValidation.Error()
+	}
+
+	if withAsyncValidation, ok := withAsyncValidation(c); ok {
+		for _, msg := range messages(withAsyncValidation.ValidateCmd()) {
+			c, _ = c.Update(msg)
+		}
+
+		if !withValidation.Validate() {
+			return withValidation.Error()
+		}
+	}
+
+	return nil
+}
+
+func answerString(value any) (string, error) {
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	case []any:
+		values := make([]string, len(typed))
+
+		for i, value := range typed {
+			answer, err := answerString(value)
+			if err != nil {
+				return "", err
+			}
+
+			values[i] = answer
+		}
+
+		return strings.Join(values, ","), nil
+	}
+
+	return "", errors.New("answer must be a string, number, boolean or list")
+}
+
+func isTerminal(v any) bool {
+	file, ok := v.(interface{ Fd() uintptr })
+
+	return ok && term.IsTerminal(file.Fd())
+}
+
+func blank(m component.Component) bool {
+	if withValues, ok := withValues(m); ok {
+		return len(withValues.Values()) == 0
+	}
+
+	if withValue, ok := withValue(m); ok {
+		return withValue.Value() == ""
+	}
+
+	return value(m) == nil
+}
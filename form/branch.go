@@ -0,0 +1,50 @@
+package form
+
+import (
+	"github.com/MrSquaare/boba/component"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Branch struct {
+	child component.Component
+	next  func() string
+}
+
+func NewBranch(child component.Component) *Branch {
+	m := &Branch{
+		child: child,
+		next:  func() string { return "" },
+	}
+
+	return m
+}
+
+func (m *Branch) Init() tea.Cmd {
+	return m.child.Init()
+}
+
+func (m *Branch) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	m.child, cmd = m.child.Update(msg)
+
+	return m, cmd
+}
+
+func (m *Branch) View() string {
+	return m.child.View()
+}
+
+func (m *Branch) Child() component.Component {
+	return m.child
+}
+
+func (m *Branch) Next() string {
+	return m.next()
+}
+
+func (m *Branch) SetNext(next func() string) *Branch {
+	m.next = next
+
+	return m
+}
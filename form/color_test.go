@@ -0,0 +1,93 @@
+package form
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/MrSquaare/boba/internal/colortest"
+	"github.com/muesli/termenv"
+)
+
+func options() []SelectItemProps {
+	return []SelectItemProps{
+		{Value: "vim", Component: component.NewOption("Vim")},
+		{Value: "emacs", Component: component.NewOption("Emacs")},
+	}
+}
+
+func TestComponentColorProfiles(t *testing.T) {
+	date := time.Date(2024, 3, 14, 9, 30, 0, 0, time.UTC)
+	components := []struct {
+		name string
+		new  func(t *testing.T) component.Component
+	}{
+		{"input", func(*testing.T) component.Component { return NewInput().SetValue("value") }},
+		{"number", func(*testing.T) component.Component { return NewNumber().SetValue(42) }},
+		{"textarea", func(*testing.T) component.Component { return NewTextArea().SetValue("value") }},
+		{"select", func(*testing.T) component.Component { return NewSelect(options()) }},
+		{"inline select", func(*testing.T) component.Component { return NewSelect(options()).SetInline(true) }},
+		{"multiselect", func(*testing.T) component.Component { return NewMultiSelect(options()).SetChecked(1, true) }},
+		{"confirm", func(*testing.T) component.Component { return NewConfirm() }},
+		{"datepicker", func(*testing.T) component.Component { return NewDatePicker().SetValue(date).SetTime(true) }},
+		{"filepicker", func(t *testing.T) component.Component { return NewFilePicker().SetDirectory(t.TempDir()) }},
+	}
+
+	for _, p := range colortest.Profiles {
+		for _, dark := range []bool{true, false} {
+			background := "light"
+
+			if dark {
+				background = "dark"
+			}
+
+			for _, c := range components {
+				t.Run(p.Name+"/"+background+"/"+c.name, func(t *testing.T) {
+					colortest.SetProfile(t, p.Profile, dark)
+
+					m := c.new(t)
+					m.Init()
+					m, _ = m.Update(component.FocusMsg{Focus: false})
+					blurred := m.View()
+					m, _ = m.Update(component.FocusMsg{Focus: true})
+					focused := m.View()
+
+					colortest.CheckProfile(t, p.Profile, blurred)
+					colortest.CheckProfile(t, p.Profile, focused)
+
+					if focused == blurred {
+						t.Errorf("focused view is indistinguishable from blurred view: %q", focused)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestDatePickerNoColorCursor(t *testing.T) {
+	colortest.SetProfile(t, termenv.Ascii, true)
+
+	m := NewDatePicker().SetValue(time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC))
+	m.Update(component.FocusMsg{Focus: true})
+
+	if view := m.View(); !strings.Contains(view, "[14]") {
+		t.Errorf("date picker view does not mark the cursor day:\n%s", view)
+	}
+}
+
+func TestFormColorProfiles(t *testing.T) {
+	for _, p := range colortest.Profiles {
+		t.Run(p.Name, func(t *testing.T) {
+			colortest.SetProfile(t, p.Profile, true)
+
+			f := NewForm([]FormItem{
+				{Name: "name", Component: NewField("Name", NewInput())},
+				{Name: "editor", Component: NewField("Editor", NewSelect(options()))},
+			}).SetTheme(component.CatppuccinTheme)
+			f.Init()
+
+			colortest.CheckProfile(t, p.Profile, f.View())
+		})
+	}
+}
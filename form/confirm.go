@@ -0,0 +1,172 @@
+package form
+
+import (
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type ConfirmState struct {
+	focus bool
+	value bool
+}
+
+type Confirm struct {
+	affirmative *component.Button
+	negative    *component.Button
+	theme       *component.Theme
+	keyMap      ConfirmKeyMap
+	state       ConfirmState
+}
+
+type ConfirmKeyMap struct {
+	Toggle key.Binding
+	Yes    key.Binding
+	No     key.Binding
+}
+
+var (
+	ConfirmDefaultKeyMap = ConfirmKeyMap{
+		Toggle: key.NewBinding(
+			key.WithKeys("left", "right"),
+			key.WithHelp("left/right", "Toggle answer"),
+		),
+		Yes: key.NewBinding(
+			key.WithKeys("y", "Y"),
+			key.WithHelp("y", "Yes"),
+		),
+		No: key.NewBinding(
+			key.WithKeys("n", "N"),
+			key.WithHelp("n", "No"),
+		),
+	}
+)
+
+func NewConfirm() *Confirm {
+	m := &Confirm{
+		affirmative: component.NewButton("Yes"),
+		negative:    component.NewButton("No"),
+		theme:       nil,
+		keyMap:      ConfirmDefaultKeyMap,
+		state: ConfirmState{
+			focus: false,
+			value: false,
+		},
+	}
+
+	return m
+}
+
+func (m *Confirm) Init() tea.Cmd {
+	return m.updateItems()
+}
+
+func (m *Confirm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	focus := m.state.focus
+	value := m.state.value
+
+	switch typedMsg := msg.(type) {
+	case component.FocusMsg:
+		m.state.focus = typedMsg.Focus
+	case component.ThemeMsg:
+		if m.theme == nil {
+			m.updateTheme(typedMsg.Theme)
+		}
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(typedMsg, m.keyMap.Toggle):
+			m.state.value = !m.state.value
+		case key.Matches(typedMsg, m.keyMap.Yes):
+			m.state.value = true
+		case key.Matches(typedMsg, m.keyMap.No):
+			m.state.value = false
+		}
+	}
+
+	if m.state.focus != focus || m.state.value != value {
+		return m, m.updateItems()
+	}
+
+	return m, nil
+}
+
+func (m *Confirm) View() string {
+	return m.affirmative.View() + " " + m.negative.View()
+}
+
+func (m *Confirm) Keys() []key.Binding {
+	return []key.Binding{m.keyMap.Toggle, m.keyMap.Yes, m.keyMap.No}
+}
+
+func (m *Confirm) TypedValue() (any, error) {
+	return m.state.value, nil
+}
+
+func (m *Confirm) Confirmed() bool {
+	return m.state.value
+}
+
+func (m *Confirm) Declined() bool {
+	return !m.state.value
+}
+
+func (m *Confirm) SetValue(value bool) *Confirm {
+	m.state.value = value
+
+	return m
+}
+
+func (m *Confirm) SetAffirmative(label string) *Confirm {
+	m.affirmative.SetLabel(label)
+
+	return m
+}
+
+func (m *Confirm) SetNegative(label string) *Confirm {
+	m.negative.SetLabel(label)
+
+	return m
+}
+
+func (m *Confirm) SetKeyMap(keyMap ConfirmKeyMap) *Confirm {
+	m.keyMap = keyMap
+
+	return m
+}
+
+func (m *Confirm) SetTheme(theme component.Theme) *Confirm {
+	m.theme = &theme
+	m.updateTheme(theme)
+
+	return m
+}
+
+func (m *Confirm) Affirmative() *component.Button {
+	return m.affirmative
+}
+
+func (m *Confirm) Negative() *component.Button {
+	return m.negative
+}
+
+func (m *Confirm) updateTheme(theme component.Theme) {
+	m.affirmative.Update(component.ThemeMsg{Theme: theme})
+	m.negative.Update(component.ThemeMsg{Theme: theme})
+}
+
+func (m *Confirm) updateItems() tea.Cmd {
+	buttons := []*component.Button{m.affirmative, m.negative}
+	cmds := make([]tea.Cmd, len(buttons)*2)
+
+	for i, button := range buttons {
+		selected := m.state.value == (i == 0)
+
+		_, focusCmd := button.Update(component.FocusMsg{Focus: selected && m.state.focus})
+		_, activeCmd := button.Update(component.ActiveMsg{Active: selected})
+
+		cmds[i*2] = focusCmd
+		cmds[i*2+1] = activeCmd
+	}
+
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,582 @@
+package form
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type DatePickerStyle struct {
+	Header     lipgloss.Style
+	Weekday    lipgloss.Style
+	Day        lipgloss.Style
+	Today      lipgloss.Style
+	Cursor     lipgloss.Style
+	Active     lipgloss.Style
+	Disabled   lipgloss.Style
+	Time       lipgloss.Style
+	TimeCursor lipgloss.Style
+	Error      lipgloss.Style
+}
+
+type DatePickerState struct {
+	focus     bool
+	value     time.Time
+	timeMode  bool
+	timeField int
+}
+
+type DatePicker struct {
+	layout       string
+	min          time.Time
+	max          time.Time
+	withTime     bool
+	minuteStep   int
+	firstWeekday time.Weekday
+	validateFunc func(time.Time) error
+	err          error
+	theme        *component.Theme
+	keyMap       DatePickerKeyMap
+	style        DatePickerStyle
+	overrides    map[string]bool
+	state        DatePickerState
+}
+
+type DatePickerKeyMap struct {
+	PrevDay   key.Binding
+	NextDay   key.Binding
+	PrevWeek  key.Binding
+	NextWeek  key.Binding
+	PrevMonth key.Binding
+	NextMonth key.Binding
+	PrevYear  key.Binding
+	NextYear  key.Binding
+	Today     key.Binding
+	Mode      key.Binding
+}
+
+var (
+	DatePickerDefaultStyle  = NewDatePickerStyle(component.DefaultTheme)
+	DatePickerDefaultKeyMap = DatePickerKeyMap{
+		PrevDay: key.NewBinding(
+			key.WithKeys("left"),
+			key.WithHelp("left", "Previous day"),
+		),
+		NextDay: key.NewBinding(
+			key.WithKeys("right"),
+			key.WithHelp("right", "Next day"),
+		),
+		PrevWeek: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithHelp("up", "Previous week"),
+		),
+		NextWeek: key.NewBinding(
+			key.WithKeys("down"),
+			key.WithHelp("down", "Next week"),
+		),
+		PrevMonth: key.NewBinding(
+			key.WithKeys("pgup", "["),
+			key.WithHelp("pgup/[", "Previous month"),
+		),
+		NextMonth: key.NewBinding(
+			key.WithKeys("pgdown", "]"),
+			key.WithHelp("pgdown/]", "Next month"),
+		),
+		PrevYear: key.NewBinding(
+			key.WithKeys("{"),
+			key.WithHelp("{", "Previous year"),
+		),
+		NextYear: key.NewBinding(
+			key.WithKeys("}"),
+			key.WithHelp("}", "Next year"),
+		),
+		Today: key.NewBinding(
+			key.WithKeys("."),
+			key.WithHelp(".", "Today"),
+		),
+		Mode: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "Date/time"),
+		),
+	}
+)
+
+func NewDatePickerStyle(theme component.Theme) DatePickerStyle {
+	return DatePickerStyle{
+		Header:     lipgloss.NewStyle().Foreground(theme.Foreground).Bold(true),
+		Weekday:    lipgloss.NewStyle().Foreground(theme.Muted),
+		Day:        lipgloss.NewStyle().Foreground(theme.Foreground),
+		Today:      lipgloss.NewStyle().Foreground(theme.Accent).Underline(true),
+		Cursor:     lipgloss.NewStyle().Foreground(theme.AccentForeground).Bold(true).Background(theme.Accent),
+		Active:     lipgloss.NewStyle().Foreground(theme.Accent).Bold(true),
+		Disabled:   lipgloss.NewStyle().Foreground(theme.Muted),
+		Time:       lipgloss.NewStyle().Foreground(theme.Foreground),
+		TimeCursor: lipgloss.NewStyle().Foreground(theme.AccentForeground).Bold(true).Background(theme.Accent),
+		Error:      lipgloss.NewStyle().Foreground(theme.Error),
+	}
+}
+
+func NewDatePicker() *DatePicker {
+	m := &DatePicker{
+		layout:       "",
+		min:          time.Time{},
+		max:          time.Time{},
+		withTime:     false,
+		minuteStep:   1,
+		firstWeekday: time.Monday,
+		validateFunc: func(time.Time) error { return nil },
+		err:          nil,
+		theme:        nil,
+		keyMap:       DatePickerDefaultKeyMap,
+		style:        DatePickerDefaultStyle,
+		overrides:    map[string]bool{},
+		state: DatePickerState{
+			focus:     false,
+			value:     today(),
+			timeMode:  false,
+			timeField: 0,
+		},
+	}
+
+	return m
+}
+
+func (m *DatePicker) Init() tea.Cmd {
+	return nil
+}
+
+func (m *DatePicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch typedMsg := msg.(type) {
+	case component.FocusMsg:
+		m.state.focus = typedMsg.Focus
+	case component.ThemeMsg:
+		if m.theme == nil {
+			m.style = component.Restyle(m.style, NewDatePickerStyle(typedMsg.Theme), m.overrides)
+		}
+	case tea.KeyMsg:
+		switch {
+		case m.withTime && key.Matches(typedMsg, m.keyMap.Mode):
+			m.state.timeMode = !m.state.timeMode
+		case m.state.timeMode:
+			m.updateTime(typedMsg)
+		default:
+			m.updateDate(typedMsg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *DatePicker) View() string {
+	var s string
+
+	value := m.state.value
+	first := time.Date(value.Year(), value.Month(), 1, 0, 0, 0, 0, value.Location())
+	width := 7*2 + 6
+
+	s += m.style.Header.Render(lipgloss.PlaceHorizontal(width, lipgloss.Center, first.Format("January 2006"))) + "\n"
+
+	weekdays := make([]string, 7)
+
+	for i := range weekdays {
+		weekdays[i] = m.style.Weekday.Render(time.Weekday((int(m.firstWeekday) + i) % 7).String()[:2])
+	}
+
+	s += m.row(weekdays, -1)
+
+	cells := []string{}
+	cursor := -1
+
+	for i := 0; i < (int(first.Weekday())-int(m.firstWeekday)+7)%7; i++ {
+		cells = append(cells, "  ")
+	}
+
+	for day := first; day.Month() == first.Month(); day = day.AddDate(0, 0, 1) {
+		if sameDay(day, value) {
+			cursor = len(cells)
+		}
+
+		cells = append(cells, m.dayView(day))
+	}
+
+	for i := 0; i < len(cells); i += 7 {
+		s += "\n" + m.row(cells[i:min(i+7, len(cells))], cursor-i)
+	}
+
+	if m.withTime {
+		s += "\n" + m.timeView()
+	}
+
+	if m.err != nil {
+		s += "\n" + m.style.Error.Render(m.err.Error())
+	}
+
+	return s
+}
+
+func (m *DatePicker) Keys() []key.Binding {
+	keys := []key.Binding{}
+
+	if m.state.timeMode {
+		keys = append(keys, m.keyMap.PrevDay, m.keyMap.NextDay, m.keyMap.PrevWeek, m.keyMap.NextWeek)
+	} else {
+		keys = append(keys, m.keyMap.PrevDay, m.keyMap.NextDay, m.keyMap.PrevWeek, m.keyMap.NextWeek, m.keyMap.PrevMonth, m.keyMap.NextMonth, m.keyMap.PrevYear, m.keyMap.NextYear, m.keyMap.Today)
+	}
+
+	if m.withTime {
+		keys = append(keys, m.keyMap.Mode)
+	}
+
+	return keys
+}
+
+func (m *DatePicker) Value() string {
+	return m.state.value.Format(m.Layout())
+}
+
+func (m *DatePicker) TypedValue() (any, error) {
+	return m.state.value, nil
+}
+
+func (m *DatePicker) Time() time.Time {
+	return m.state.value
+}
+
+func (m *DatePicker) Layout() string {
+	if m.layout != "" {
+		return m.layout
+	}
+
+	if m.withTime {
+		return "2006-01-02 15:04"
+	}
+
+	return time.DateOnly
+}
+
+func (m *DatePicker) Validate() bool {
+	switch {
+	case !m.min.IsZero() && m.state.value.Before(m.min):
+		m.err = fmt.Errorf("date must be on or after %s", m.min.Format(m.Layout()))
+	case !m.max.IsZero() && m.state.value.After(m.max):
+		m.err = fmt.Errorf("date must be on or before %s", m.max.Format(m.Layout()))
+	default:
+		m.err = m.validateFunc(m.state.value)
+	}
+
+	return m.err == nil
+}
+
+func (m *DatePicker) Error() error {
+	return m.err
+}
+
+func (m *DatePicker) SetError(err error) {
+	m.err = err
+}
+
+func (m *DatePicker) SetValue(value time.Time) *DatePicker {
+	m.state.value = m.clamp(value)
+
+	return m
+}
+
+func (m *DatePicker) SetLayout(layout string) *DatePicker {
+	m.layout = layout
+
+	return m
+}
+
+func (m *DatePicker) SetMin(min time.Time) *DatePicker {
+	m.min = min
+	m.state.value = m.clamp(m.state.value)
+
+	return m
+}
+
+func (m *DatePicker) SetMax(max time.Time) *DatePicker {
+	m.max = max
+	m.state.value = m.clamp(m.state.value)
+
+	return m
+}
+
+func (m *DatePicker) SetTime(withTime bool) *DatePicker {
+	m.withTime = withTime
+
+	if !withTime {
+		m.state.timeMode = false
+	}
+
+	return m
+}
+
+func (m *DatePicker) SetMinuteStep(step int) *DatePicker {
+	m.minuteStep = max(step, 1)
+
+	return m
+}
+
+func (m *DatePicker) SetFirstWeekday(weekday time.Weekday) *DatePicker {
+	m.firstWeekday = weekday
+
+	return m
+}
+
+func (m *DatePicker) SetValidateFunc(fn func(time.Time) error) *DatePicker {
+	m.validateFunc = fn
+
+	return m
+}
+
+func (m *DatePicker) SetKeyMap(keyMap DatePickerKeyMap) *DatePicker {
+	m.keyMap = keyMap
+
+	return m
+}
+
+func (m *DatePicker) SetTheme(theme component.Theme) *DatePicker {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewDatePickerStyle(theme), m.overrides)
+
+	return m
+}
+
+func (m *DatePicker) SetHeaderStyle(style lipgloss.Style) *DatePicker {
+	m.style.Header = style
+	m.overrides["Header"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetWeekdayStyle(style lipgloss.Style) *DatePicker {
+	m.style.Weekday = style
+	m.overrides["Weekday"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetDayStyle(style lipgloss.Style) *DatePicker {
+	m.style.Day = style
+	m.overrides["Day"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetTodayStyle(style lipgloss.Style) *DatePicker {
+	m.style.Today = style
+	m.overrides["Today"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetCursorStyle(style lipgloss.Style) *DatePicker {
+	m.style.Cursor = style
+	m.overrides["Cursor"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetActiveStyle(style lipgloss.Style) *DatePicker {
+	m.style.Active = style
+	m.overrides["Active"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetDisabledStyle(style lipgloss.Style) *DatePicker {
+	m.style.Disabled = style
+	m.overrides["Disabled"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetTimeStyle(style lipgloss.Style) *DatePicker {
+	m.style.Time = style
+	m.overrides["Time"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetTimeCursorStyle(style lipgloss.Style) *DatePicker {
+	m.style.TimeCursor = style
+	m.overrides["TimeCursor"] = true
+
+	return m
+}
+
+func (m *DatePicker) SetErrorStyle(style lipgloss.Style) *DatePicker {
+	m.style.Error = style
+	m.overrides["Error"] = true
+
+	return m
+}
+
+func (m *DatePicker) updateDate(msg tea.KeyMsg) {
+	value := m.state.value
+
+	switch {
+	case key.Matches(msg, m.keyMap.PrevDay):
+		value = value.AddDate(0, 0, -1)
+	case key.Matches(msg, m.keyMap.NextDay):
+		value = value.AddDate(0, 0, 1)
+	case key.Matches(msg, m.keyMap.PrevWeek):
+		value = value.AddDate(0, 0, -7)
+	case key.Matches(msg, m.keyMap.NextWeek):
+		value = value.AddDate(0, 0, 7)
+	case key.Matches(msg, m.keyMap.PrevMonth):
+		value = addMonths(value, -1)
+	case key.Matches(msg, m.keyMap.NextMonth):
+		value = addMonths(value, 1)
+	case key.Matches(msg, m.keyMap.PrevYear):
+		value = addMonths(value, -12)
+	case key.Matches(msg, m.keyMap.NextYear):
+		value = addMonths(value, 12)
+	case key.Matches(msg, m.keyMap.Today):
+		now := today()
+		value = time.Date(now.Year(), now.Month(), now.Day(), value.Hour(), value.Minute(), 0, 0, value.Location())
+	}
+
+	m.state.value = m.clamp(value)
+}
+
+func (m *DatePicker) updateTime(msg tea.KeyMsg) {
+	value := m.state.value
+
+	switch {
+	case key.Matches(msg, m.keyMap.PrevDay):
+		m.state.timeField = 0
+	case key.Matches(msg, m.keyMap.NextDay):
+		m.state.timeField = 1
+	case key.Matches(msg, m.keyMap.PrevWeek):
+		value = m.addTime(value, 1)
+	case key.Matches(msg, m.keyMap.NextWeek):
+		value = m.addTime(value, -1)
+	}
+
+	m.state.value = m.clamp(value)
+}
+
+func (m *DatePicker) addTime(value time.Time, delta int) time.Time {
+	hour := value.Hour()
+	minute := value.Minute()
+
+	if m.state.timeField == 0 {
+		hour = (hour + delta + 24) % 24
+	} else {
+		minute = (minute + delta*m.minuteStep + 60) % 60
+		minute -= minute % m.minuteStep
+	}
+
+	return time.Date(value.Year(), value.Month(), value.Day(), hour, minute, 0, 0, value.Location())
+}
+
+func (m *DatePicker) clamp(value time.Time) time.Time {
+	if !m.min.IsZero() && value.Before(m.min) {
+		return m.min
+	}
+
+	if !m.max.IsZero() && value.After(m.max) {
+		return m.max
+	}
+
+	return value
+}
+
+func (m *DatePicker) disabled(day time.Time) bool {
+	end := day.AddDate(0, 0, 1)
+
+	return (!m.min.IsZero() && !end.After(m.min)) || (!m.max.IsZero() && day.After(m.max))
+}
+
+func (m *DatePicker) dayView(day time.Time) string {
+	label := fmt.Sprintf("%2d", day.Day())
+	now := today()
+
+	switch {
+	case sameDay(day, m.state.value) && m.state.focus && !m.state.timeMode:
+		return m.style.Cursor.Render(label)
+	case sameDay(day, m.state.value):
+		return m.style.Active.Render(label)
+	case m.disabled(day):
+		return m.style.Disabled.Render(label)
+	case sameDay(day, now):
+		return m.style.Today.Render(label)
+	}
+
+	return m.style.Day.Render(label)
+}
+
+func (m *DatePicker) timeView() string {
+	fields := []string{
+		fmt.Sprintf("%02d", m.state.value.Hour()),
+		fmt.Sprintf("%02d", m.state.value.Minute()),
+	}
+
+	for i, field := range fields {
+		if m.state.focus && m.state.timeMode && i == m.state.timeField && component.NoColor() {
+			fields[i] = m.style.TimeCursor.Render("[" + field + "]")
+		} else if m.state.focus && m.state.timeMode && i == m.state.timeField {
+			fields[i] = m.style.TimeCursor.Render(field)
+		} else {
+			fields[i] = m.style.Time.Render(field)
+		}
+	}
+
+	return m.style.Time.Render("Time: ") + strings.Join(fields, m.style.Time.Render(":"))
+}
+
+func (m *DatePicker) row(cells []string, cursor int) string {
+	if !component.NoColor() {
+		return strings.Join(cells, " ")
+	}
+
+	var s string
+
+	left, right := "(", ")"
+
+	if m.state.focus && !m.state.timeMode {
+		left, right = "[", "]"
+	}
+
+	for i, cell := range cells {
+		switch {
+		case i == cursor:
+			s += left
+		case cursor >= 0 && i == cursor+1:
+			s += right
+		default:
+			s += " "
+		}
+
+		s += cell
+	}
+
+	if cursor == len(cells)-1 {
+		s += right
+	}
+
+	return s
+}
+
+func today() time.Time {
+	now := time.Now()
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+func sameDay(a time.Time, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
+
+func addMonths(value time.Time, months int) time.Time {
+	first := time.Date(value.Year(), value.Month()+time.Month(months), 1, value.Hour(), value.Minute(), 0, 0, value.Location())
+	last := first.AddDate(0, 1, -1).Day()
+
+	return time.Date(first.Year(), first.Month(), min(value.Day(), last), value.Hour(), value.Minute(), 0, 0, value.Location())
+}
@@ -0,0 +1,320 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/MrSquaare/boba/component"
+)
+
+type DecodeError struct {
+	Name  string
+	Field string
+	Err   error
+}
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Name, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func (m *Form) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode target must be a non-nil pointer to a struct, got %T", v)
+	}
+
+	rv = rv.Elem()
+	rt := rv.Type()
+	errs := []error{}
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := fieldName(field)
+
+		if name == "-" {
+			continue
+		}
+
+		item, ok := m.item(name)
+
+		if !ok {
+			continue
+		}
+
+		value, err := itemValue(item.Component)
+
+		if err == nil && value != nil {
+			err = assign(rv.Field(i), value)
+		}
+
+		if err != nil {
+			errs = append(errs, &DecodeError{
+				Name:  item.Name,
+				Field: field.Name,
+				Err:   err,
+			})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *Form) decodeTarget() error {
+	if m.target == nil {
+		return nil
+	}
+
+	target := reflect.ValueOf(m.target).Elem()
+	decoded := reflect.New(target.Type())
+
+	decoded.Elem().Set(target)
+
+	if err := m.Decode(decoded.Interface()); err != nil {
+		return err
+	}
+
+	target.Set(decoded.Elem())
+
+	return nil
+}
+
+func (m *Form) item(name string) (FormItem, bool) {
+	for i, item := range m.items {
+		if strings.EqualFold(item.Name, name) && m.taken(i) {
+			return item, true
+		}
+	}
+
+	return FormItem{}, false
+}
+
+func itemValue(m component.Component) (any, error) {
+	if withTypedValue, ok := withTypedValue(m); ok {
+		return withTypedValue.TypedValue()
+	}
+
+	if withValues, ok := withValues(m); ok {
+		return withValues.Values(), nil
+	}
+
+	if withValue, ok := withValue(m); ok {
+		return withValue.Value(), nil
+	}
+
+	return nil, nil
+}
+
+func fieldName(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup("boba"); ok {
+		if tag == "-" {
+			return "-"
+		}
+
+		if name := parseTag(tag)["name"]; name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+func parseTag(tag string) map[string]string {
+	options := map[string]string{}
+	parts := []string{}
+	part := ""
+	quoted := false
+
+	for _, r := range tag {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			parts = append(parts, part)
+			part = ""
+		default:
+			part += string(r)
+		}
+	}
+
+	parts = append(parts, part)
+
+	for _, part := range parts {
+		key, value, _ := strings.Cut(part, "=")
+		key = strings.TrimSpace(key)
+
+		if key != "" {
+			options[key] = strings.TrimSpace(value)
+		}
+	}
+
+	return options
+}
+
+func assign(dst reflect.Value, value any) error {
+	src := reflect.ValueOf(value)
+
+	if dst.Kind() == reflect.Pointer {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+
+		return assign(dst.Elem(), value)
+	}
+
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+
+		return nil
+	}
+
+	if s, ok := value.(string); ok {
+		return assignString(dst, s)
+	}
+
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch src.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if dst.OverflowInt(src.Int()) {
+				return fmt.Errorf("%d overflows %s", src.Int(), dst.Type())
+			}
+
+			dst.SetInt(src.Int())
+
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch src.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if src.Int() < 0 || dst.OverflowUint(uint64(src.Int())) {
+				return fmt.Errorf("%d overflows %s", src.Int(), dst.Type())
+			}
+
+			dst.SetUint(uint64(src.Int()))
+
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch src.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			dst.SetFloat(float64(src.Int()))
+
+			return nil
+		case reflect.Float32, reflect.Float64:
+			dst.SetFloat(src.Float())
+
+			return nil
+		}
+	}
+
+	if src.Type().ConvertibleTo(dst.Type()) && src.Kind() == dst.Kind() {
+		dst.Set(src.Convert(dst.Type()))
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot convert %T to %s", value, dst.Type())
+}
+
+func assignString(dst reflect.Value, s string) error {
+	switch dst.Type() {
+	case durationType:
+		v, err := parseDuration(s)
+		if err != nil {
+			return err
+		}
+
+		dst.SetInt(int64(v))
+
+		return nil
+	case timeType:
+		v, err := parseTime(s, time.RFC3339)
+		if err != nil {
+			return err
+		}
+
+		dst.Set(reflect.ValueOf(v))
+
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Bool:
+		v, err := parseBool(s)
+		if err != nil {
+			return err
+		}
+
+		dst.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := parseInt(s)
+		if err != nil {
+			return err
+		}
+
+		if dst.OverflowInt(int64(v)) {
+			return fmt.Errorf("%d overflows %s", v, dst.Type())
+		}
+
+		dst.SetInt(int64(v))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := parseInt(s)
+		if err != nil {
+			return err
+		}
+
+		if v < 0 || dst.OverflowUint(uint64(v)) {
+			return fmt.Errorf("%d overflows %s", v, dst.Type())
+		}
+
+		dst.SetUint(uint64(v))
+	case reflect.Float32, reflect.Float64:
+		v, err := parseFloat(s)
+		if err != nil {
+			return err
+		}
+
+		if dst.OverflowFloat(v) {
+			return fmt.Errorf("%g overflows %s", v, dst.Type())
+		}
+
+		dst.SetFloat(v)
+	case reflect.Slice:
+		if dst.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("cannot convert string to %s", dst.Type())
+		}
+
+		values := parseStrings(s, ",")
+		slice := reflect.MakeSlice(dst.Type(), len(values), len(values))
+
+		for i, value := range values {
+			slice.Index(i).SetString(value)
+		}
+
+		dst.Set(slice)
+	default:
+		return fmt.Errorf("cannot convert string to %s", dst.Type())
+	}
+
+	return nil
+}
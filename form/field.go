@@ -11,22 +11,30 @@ type FieldStyle struct {
 }
 
 type Field struct {
-	label string
-	child component.Component
-	style FieldStyle
+	label     string
+	child     component.Component
+	theme     *component.Theme
+	style     FieldStyle
+	overrides map[string]bool
 }
 
 var (
-	fieldDefaultStyle = FieldStyle{
-		TextBase: lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")),
-	}
+	fieldDefaultStyle = NewFieldStyle(component.DefaultTheme)
 )
 
+func NewFieldStyle(theme component.Theme) FieldStyle {
+	return FieldStyle{
+		TextBase: lipgloss.NewStyle().Foreground(theme.Foreground),
+	}
+}
+
 func NewField(label string, child component.Component) *Field {
 	m := &Field{
-		label: label,
-		child: child,
-		style: fieldDefaultStyle,
+		label:     label,
+		child:     child,
+		theme:     nil,
+		style:     fieldDefaultStyle,
+		overrides: map[string]bool{},
 	}
 
 	return m
@@ -37,6 +45,14 @@ func (m *Field) Init() tea.Cmd {
 }
 
 func (m *Field) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if typedMsg, ok := msg.(component.ThemeMsg); ok {
+		if m.theme != nil {
+			return m, nil
+		}
+
+		m.style = component.Restyle(m.style, NewFieldStyle(typedMsg.Theme), m.overrides)
+	}
+
 	var cmd tea.Cmd
 
 	m.child, cmd = m.child.Update(msg)
@@ -57,8 +73,17 @@ func (m *Field) Child() component.Component {
 	return m.child
 }
 
+func (m *Field) SetTheme(theme component.Theme) *Field {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewFieldStyle(theme), m.overrides)
+	m.child, _ = m.child.Update(component.ThemeMsg{Theme: theme})
+
+	return m
+}
+
 func (m *Field) SetTextBaseStyle(style lipgloss.Style) *Field {
 	m.style.TextBase = style
+	m.overrides["TextBase"] = true
 
 	return m
 }
@@ -0,0 +1,479 @@
+package form
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type FilePickerStyle struct {
+	Path        lipgloss.Style
+	Placeholder lipgloss.Style
+	Cursor      lipgloss.Style
+	Directory   lipgloss.Style
+	File        lipgloss.Style
+	Selected    lipgloss.Style
+	Disabled    lipgloss.Style
+	Info        lipgloss.Style
+	Error       lipgloss.Style
+}
+
+type FilePickerState struct {
+	focus bool
+	path  string
+}
+
+type FilePicker struct {
+	placeholder  string
+	dirOnly      bool
+	validateFunc func(string) error
+	err          error
+	theme        *component.Theme
+	picker       filepicker.Model
+	keyMap       FilePickerKeyMap
+	style        FilePickerStyle
+	overrides    map[string]bool
+	state        FilePickerState
+}
+
+type FilePickerKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Top      key.Binding
+	Last     key.Binding
+	Back     key.Binding
+	Open     key.Binding
+	Select   key.Binding
+	Hidden   key.Binding
+}
+
+var (
+	FilePickerDefaultStyle  = NewFilePickerStyle(component.DefaultTheme)
+	FilePickerDefaultKeyMap = FilePickerKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("up/k", "Previous file"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("down/j", "Next file"),
+		),
+		PageUp: key.NewBinding(
+			key.WithKeys("pgup"),
+			key.WithHelp("pgup", "Previous page"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("pgdown"),
+			key.WithHelp("pgdown", "Next page"),
+		),
+		Top: key.NewBinding(
+			key.WithKeys("home", "g"),
+			key.WithHelp("home/g", "First file"),
+		),
+		Last: key.NewBinding(
+			key.WithKeys("end", "G"),
+			key.WithHelp("end/G", "Last file"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("left", "backspace", "h"),
+			key.WithHelp("left/h", "Parent directory"),
+		),
+		Open: key.NewBinding(
+			key.WithKeys("right", "enter", "l"),
+			key.WithHelp("right/l", "Open directory"),
+		),
+		Select: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "Select path"),
+		),
+		Hidden: key.NewBinding(
+			key.WithKeys("."),
+			key.WithHelp(".", "Toggle hidden files"),
+		),
+	}
+)
+
+func NewFilePickerStyle(theme component.Theme) FilePickerStyle {
+	return FilePickerStyle{
+		Path:        lipgloss.NewStyle().Foreground(theme.Accent),
+		Placeholder: lipgloss.NewStyle().Foreground(theme.Muted),
+		Cursor:      lipgloss.NewStyle().Foreground(theme.Accent),
+		Directory:   lipgloss.NewStyle().Foreground(theme.Accent),
+		File:        lipgloss.NewStyle().Foreground(theme.Foreground),
+		Selected:    lipgloss.NewStyle().Foreground(theme.Accent).Bold(true),
+		Disabled:    lipgloss.NewStyle().Foreground(theme.Muted),
+		Info:        lipgloss.NewStyle().Foreground(theme.Muted),
+		Error:       lipgloss.NewStyle().Foreground(theme.Error),
+	}
+}
+
+func NewFilePicker() *FilePicker {
+	m := &FilePicker{
+		placeholder:  "No path selected",
+		dirOnly:      false,
+		validateFunc: func(string) error { return nil },
+		err:          nil,
+		theme:        nil,
+		picker:       filepicker.New(),
+		keyMap:       FilePickerDefaultKeyMap,
+		style:        FilePickerDefaultStyle,
+		overrides:    map[string]bool{},
+		state: FilePickerState{
+			focus: false,
+			path:  "",
+		},
+	}
+
+	m.picker.AutoHeight = false
+	m.picker.Height = 10
+	m.picker.ShowPermissions = false
+	m.updateKeyMap()
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) Init() tea.Cmd {
+	return nil
+}
+
+func (m *FilePicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	cmds := []tea.Cmd{}
+
+	switch typedMsg := msg.(type) {
+	case component.FocusMsg:
+		focus := m.state.focus
+
+		m.state.focus = typedMsg.Focus
+
+		if m.state.focus && !focus {
+			return m, m.picker.Init()
+		}
+
+		return m, nil
+	case component.ThemeMsg:
+		if m.theme == nil {
+			m.style = component.Restyle(m.style, NewFilePickerStyle(typedMsg.Theme), m.overrides)
+			m.updateStyle()
+		}
+
+		return m, nil
+	case tea.KeyMsg:
+		if key.Matches(typedMsg, m.keyMap.Hidden) {
+			m.picker.ShowHidden = !m.picker.ShowHidden
+
+			return m, m.picker.Init()
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.picker.Path = ""
+	m.picker, cmd = m.picker.Update(msg)
+
+	cmds = append(cmds, cmd)
+
+	if path := m.picker.Path; path != "" {
+		if m.dirOnly || m.allowed(path) {
+			m.state.path = path
+			m.err = nil
+
+			cmds = append(cmds, func() tea.Msg {
+				return NextMsg{}
+			})
+		} else {
+			m.err = fmt.Errorf("%s is not an allowed file type", filepath.Base(path))
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *FilePicker) View() string {
+	var s string
+
+	if m.state.focus {
+		s += strings.TrimRight(m.picker.View(), "\n") + "\n"
+	}
+
+	if m.state.path != "" {
+		s += m.style.Path.Render(m.state.path)
+	} else {
+		s += m.style.Placeholder.Render(m.placeholder)
+	}
+
+	if m.err != nil {
+		s += "\n" + m.style.Error.Render(m.err.Error())
+	}
+
+	return s
+}
+
+func (m *FilePicker) Captures() []key.Binding {
+	return []key.Binding{m.keyMap.Back, m.keyMap.Open, m.keyMap.Select}
+}
+
+func (m *FilePicker) Keys() []key.Binding {
+	return []key.Binding{m.keyMap.Up, m.keyMap.Down, m.keyMap.Back, m.keyMap.Open, m.keyMap.Select, m.keyMap.Hidden}
+}
+
+func (m *FilePicker) Value() string {
+	return m.state.path
+}
+
+func (m *FilePicker) Directory() string {
+	return m.picker.CurrentDirectory
+}
+
+func (m *FilePicker) Validate() bool {
+	m.err = m.validatePath(m.state.path)
+
+	if m.err == nil {
+		m.err = m.validateFunc(m.state.path)
+	}
+
+	return m.err == nil
+}
+
+func (m *FilePicker) Error() error {
+	return m.err
+}
+
+func (m *FilePicker) SetError(err error) {
+	m.err = err
+}
+
+func (m *FilePicker) SetDirectory(directory string) *FilePicker {
+	m.picker.CurrentDirectory = expandPath(directory)
+
+	return m
+}
+
+func (m *FilePicker) SetValue(path string) *FilePicker {
+	m.state.path = expandPath(path)
+
+	return m
+}
+
+func (m *FilePicker) SetPlaceholder(placeholder string) *FilePicker {
+	m.placeholder = placeholder
+
+	return m
+}
+
+func (m *FilePicker) SetAllowedTypes(types []string) *FilePicker {
+	m.picker.AllowedTypes = types
+
+	return m
+}
+
+func (m *FilePicker) SetShowHidden(show bool) *FilePicker {
+	m.picker.ShowHidden = show
+
+	return m
+}
+
+func (m *FilePicker) SetShowPermissions(show bool) *FilePicker {
+	m.picker.ShowPermissions = show
+
+	return m
+}
+
+func (m *FilePicker) SetShowSize(show bool) *FilePicker {
+	m.picker.ShowSize = show
+
+	return m
+}
+
+func (m *FilePicker) SetDirOnly(dirOnly bool) *FilePicker {
+	m.dirOnly = dirOnly
+	m.picker.DirAllowed = dirOnly
+	m.picker.FileAllowed = !dirOnly
+
+	return m
+}
+
+func (m *FilePicker) SetHeight(height int) *FilePicker {
+	m.picker.Height = height
+
+	return m
+}
+
+func (m *FilePicker) SetValidateFunc(fn func(string) error) *FilePicker {
+	m.validateFunc = fn
+
+	return m
+}
+
+func (m *FilePicker) SetKeyMap(keyMap FilePickerKeyMap) *FilePicker {
+	m.keyMap = keyMap
+	m.updateKeyMap()
+
+	return m
+}
+
+func (m *FilePicker) SetTheme(theme component.Theme) *FilePicker {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewFilePickerStyle(theme), m.overrides)
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) SetPathStyle(style lipgloss.Style) *FilePicker {
+	m.style.Path = style
+	m.overrides["Path"] = true
+
+	return m
+}
+
+func (m *FilePicker) SetPlaceholderStyle(style lipgloss.Style) *FilePicker {
+	m.style.Placeholder = style
+	m.overrides["Placeholder"] = true
+
+	return m
+}
+
+func (m *FilePicker) SetCursorStyle(style lipgloss.Style) *FilePicker {
+	m.style.Cursor = style
+	m.overrides["Cursor"] = true
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) SetDirectoryStyle(style lipgloss.Style) *FilePicker {
+	m.style.Directory = style
+	m.overrides["Directory"] = true
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) SetFileStyle(style lipgloss.Style) *FilePicker {
+	m.style.File = style
+	m.overrides["File"] = true
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) SetSelectedStyle(style lipgloss.Style) *FilePicker {
+	m.style.Selected = style
+	m.overrides["Selected"] = true
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) SetDisabledStyle(style lipgloss.Style) *FilePicker {
+	m.style.Disabled = style
+	m.overrides["Disabled"] = true
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) SetInfoStyle(style lipgloss.Style) *FilePicker {
+	m.style.Info = style
+	m.overrides["Info"] = true
+	m.updateStyle()
+
+	return m
+}
+
+func (m *FilePicker) SetErrorStyle(style lipgloss.Style) *FilePicker {
+	m.style.Error = style
+	m.overrides["Error"] = true
+
+	return m
+}
+
+func (m *FilePicker) validatePath(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%s does not exist", path)
+	}
+
+	if m.dirOnly && !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", path)
+	}
+
+	if !m.dirOnly && info.IsDir() {
+		return fmt.Errorf("%s is a directory", path)
+	}
+
+	if !m.dirOnly && !m.allowed(path) {
+		return fmt.Errorf("%s is not an allowed file type", filepath.Base(path))
+	}
+
+	return nil
+}
+
+func (m *FilePicker) allowed(path string) bool {
+	if len(m.picker.AllowedTypes) == 0 {
+		return true
+	}
+
+	for _, allowedType := range m.picker.AllowedTypes {
+		if strings.HasSuffix(path, allowedType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *FilePicker) updateKeyMap() {
+	m.picker.KeyMap = filepicker.KeyMap{
+		GoToTop:  m.keyMap.Top,
+		GoToLast: m.keyMap.Last,
+		Down:     m.keyMap.Down,
+		Up:       m.keyMap.Up,
+		PageUp:   m.keyMap.PageUp,
+		PageDown: m.keyMap.PageDown,
+		Back:     m.keyMap.Back,
+		Open:     m.keyMap.Open,
+		Select:   m.keyMap.Select,
+	}
+}
+
+func (m *FilePicker) updateStyle() {
+	m.picker.Styles.Cursor = m.style.Cursor
+	m.picker.Styles.DisabledCursor = m.style.Disabled
+	m.picker.Styles.Symlink = m.style.File
+	m.picker.Styles.Directory = m.style.Directory
+	m.picker.Styles.File = m.style.File
+	m.picker.Styles.DisabledFile = m.style.Disabled
+	m.picker.Styles.Selected = m.style.Selected
+	m.picker.Styles.DisabledSelected = m.style.Disabled
+	m.picker.Styles.Permission = m.style.Info
+	m.picker.Styles.FileSize = m.picker.Styles.FileSize.Foreground(m.style.Info.GetForeground())
+	m.picker.Styles.EmptyDirectory = m.picker.Styles.EmptyDirectory.Foreground(m.style.Info.GetForeground())
+}
+
+func expandPath(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
@@ -1,9 +1,15 @@
 package form
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+
 	"github.com/MrSquaare/boba/component"
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 type FormItem struct {
@@ -11,24 +17,73 @@ type FormItem struct {
 	Component component.Component
 }
 
+type FormStyle struct {
+	Progress    lipgloss.Style
+	Title       lipgloss.Style
+	Description lipgloss.Style
+	Error       lipgloss.Style
+}
+
+type FormStatus = int
+
+const (
+	FormStatusEditing FormStatus = iota
+	FormStatusSubmitting
+	FormStatusSubmitted
+	FormStatusCancelled
+)
+
 type FormState struct {
 	selectedIndex int
 	step          int
-	completed     bool
+	path          []int
+	position      int
+	validating    bool
+	errors        map[string]error
+	prefilled     map[string]bool
+	submitErr     error
+	status        FormStatus
 }
 
 type Form struct {
-	items []FormItem
-	state FormState
+	id             int
+	items          []FormItem
+	groups         []Group
+	progressFunc   func(page int, pages int) string
+	target         any
+	validateFunc   func(map[string]any) map[string]error
+	validateOnNext bool
+	submitFunc     func(map[string]any) error
+	keyErr         error
+	spinner        spinner.Model
+	keyMap         FormKeyMap
+	style          FormStyle
+	overrides      map[string]bool
+	state          FormState
 }
 
 type FormKeyMap struct {
-	Prev key.Binding
-	Next key.Binding
+	Prev   key.Binding
+	Next   key.Binding
+	Cancel key.Binding
+}
+
+const RedactedValue = "********"
+
+type FormSubmittedMsg struct {
+	Values map[string]any
+}
+
+type FormCancelledMsg struct{}
+
+type formSubmitMsg struct {
+	id  int
+	err error
 }
 
 var (
-	formKeyMap = FormKeyMap{
+	FormDefaultStyle  = NewFormStyle(component.DefaultTheme)
+	FormDefaultKeyMap = FormKeyMap{
 		Prev: key.NewBinding(
 			key.WithKeys("shift+tab"),
 			key.WithHelp("shift+tab", "Previous"),
@@ -37,19 +92,52 @@ var (
 			key.WithKeys("enter", "tab"),
 			key.WithHelp("enter/tab", "Next"),
 		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "Cancel"),
+		),
 	}
 )
 
+func NewFormStyle(theme component.Theme) FormStyle {
+	return FormStyle{
+		Progress:    lipgloss.NewStyle().Foreground(theme.Muted),
+		Title:       lipgloss.NewStyle().Foreground(theme.Foreground).Bold(true),
+		Description: lipgloss.NewStyle().Foreground(theme.Muted),
+		Error:       lipgloss.NewStyle().Foreground(theme.Error),
+	}
+}
+
 func NewForm(items []FormItem) *Form {
 	m := &Form{
-		items: items,
+		id:             nextID(),
+		items:          items,
+		groups:         nil,
+		progressFunc:   progress,
+		target:         nil,
+		validateFunc:   nil,
+		validateOnNext: false,
+		submitFunc:     nil,
+		keyErr:         nil,
+		spinner:        spinner.New(),
+		keyMap:         FormDefaultKeyMap,
+		style:          FormDefaultStyle,
+		overrides:      map[string]bool{},
 		state: FormState{
 			selectedIndex: 0,
 			step:          0,
-			completed:     false,
+			path:          []int{0},
+			position:      0,
+			validating:    false,
+			errors:        map[string]error{},
+			prefilled:     map[string]bool{},
+			submitErr:     nil,
+			status:        FormStatusEditing,
 		},
 	}
 
+	m.checkKeys()
+
 	return m
 }
 
@@ -62,45 +150,71 @@ func (m *Form) Update(msg tea.Msg) (*Form, tea.Cmd) {
 
 	selectedIndex := m.state.selectedIndex
 
+	switch m.state.status {
+	case FormStatusSubmitting:
+		switch typedMsg := msg.(type) {
+		case formSubmitMsg:
+			if typedMsg.id == m.id {
+				return m, m.submitted(typedMsg.err)
+			}
+		case tea.KeyMsg:
+			if key.Matches(typedMsg, m.keyMap.Cancel) {
+				return m, m.Cancel()
+			}
+
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+
+		m.spinner, cmd = m.spinner.Update(msg)
+
+		return m, cmd
+	case FormStatusSubmitted, FormStatusCancelled:
+		return m, nil
+	}
+
 	switch typedMsg := msg.(type) {
+	case tea.WindowSizeMsg:
+		return m, m.resizeItems(typedMsg)
+	case NextMsg:
+		msg = nil
+
+		cmds = append(cmds, m.next())
 	case tea.KeyMsg:
+		m.state.validating = false
+
+		if withCapture, ok := withCapture(m.items[m.state.selectedIndex].Component); ok && key.Matches(typedMsg, withCapture.Captures()...) {
+			break
+		}
+
 		switch {
-		case key.Matches(typedMsg, formKeyMap.Prev):
+		case key.Matches(typedMsg, m.keyMap.Cancel):
 			msg = nil
 
-			m.state.completed = false
-
-			for i := m.state.selectedIndex - 1; i >= 0; i-- {
-				if !isSkip(m.items[i].Component) {
-					m.state.selectedIndex = i
+			cmds = append(cmds, m.Cancel())
+		case key.Matches(typedMsg, m.keyMap.Prev):
+			msg = nil
 
-					break
-				}
-			}
-		case key.Matches(typedMsg, formKeyMap.Next):
+			m.prev()
+		case key.Matches(typedMsg, m.keyMap.Next):
 			msg = nil
 
-			if withValidation, ok := withValidation(m.items[m.state.selectedIndex].Component); ok {
-				if !withValidation.Validate() {
-					break
-				}
-			}
+			cmds = append(cmds, m.next())
+		}
+	}
 
-			for i := m.state.selectedIndex + 1; i <= len(m.items); i++ {
-				if i == len(m.items) {
-					m.state.completed = true
+	var cmd tea.Cmd
 
-					break
-				} else if !isSkip(m.items[i].Component) {
-					m.state.selectedIndex = i
+	m.items[m.state.selectedIndex].Component, cmd = m.items[m.state.selectedIndex].Component.Update(msg)
 
-					break
-				}
-			}
+	cmds = append(cmds, cmd)
 
-			if m.state.step < m.state.selectedIndex {
-				m.state.step = m.state.selectedIndex
-			}
+	if m.state.validating {
+		if withAsyncValidation, ok := withAsyncValidation(m.items[m.state.selectedIndex].Component); !ok || !withAsyncValidation.Validating() {
+			m.state.validating = false
+
+			cmds = append(cmds, m.next())
 		}
 	}
 
@@ -108,20 +222,15 @@ func (m *Form) Update(msg tea.Msg) (*Form, tea.Cmd) {
 		cmds = append(cmds, m.updateItems())
 	}
 
-	var cmd tea.Cmd
-
-	m.items[m.state.selectedIndex].Component, cmd = m.items[m.state.selectedIndex].Component.Update(msg)
-
-	cmds = append(cmds, cmd)
-
 	return m, tea.Batch(cmds...)
 }
 
 func (m *Form) View() string {
-	var s string
+	s := m.groupView()
+	page := m.Page()
 
 	for i, item := range m.items {
-		if i > m.state.step {
+		if !m.shown(i) || m.group(i) != page {
 			continue
 		}
 
@@ -131,11 +240,25 @@ func (m *Form) View() string {
 			}
 		}
 
-		if i > 0 {
+		if s != "" {
 			s += "\n\n"
 		}
 
 		s += item.Component.View()
+
+		if err := m.state.errors[item.Name]; err != nil {
+			if _, ok := withError(item.Component); !ok {
+				s += "\n" + m.style.Error.Render(err.Error())
+			}
+		}
+	}
+
+	if m.state.status == FormStatusSubmitting {
+		s += "\n\n" + m.spinner.View()
+	}
+
+	if m.state.submitErr != nil {
+		s += "\n\n" + m.style.Error.Render(m.state.submitErr.Error())
 	}
 
 	return s
@@ -150,10 +273,10 @@ func (m *Form) Keys() []key.Binding {
 	}
 
 	if m.state.selectedIndex > 0 {
-		keys = append(keys, formKeyMap.Prev)
+		keys = append(keys, m.keyMap.Prev)
 	}
 
-	keys = append(keys, formKeyMap.Next)
+	keys = append(keys, m.keyMap.Next, m.keyMap.Cancel)
 
 	return keys
 }
@@ -162,10 +285,12 @@ func (m *Form) Error(name string) error {
 	for _, item := range m.items {
 		if item.Name == name {
 			if withValidation, ok := withValidation(item.Component); ok {
-				return withValidation.Error()
-			} else {
-				return nil
+				if err := withValidation.Error(); err != nil {
+					return err
+				}
 			}
+
+			return m.state.errors[name]
 		}
 	}
 
@@ -185,11 +310,7 @@ func (m *Form) Errors() map[string]error {
 func (m *Form) Value(name string) any {
 	for _, item := range m.items {
 		if item.Name == name {
-			if withValue, ok := withValue(item.Component); ok {
-				return withValue.Value()
-			} else {
-				return nil
-			}
+			return value(item.Component)
 		}
 	}
 
@@ -199,15 +320,39 @@ func (m *Form) Value(name string) any {
 func (m *Form) Values() map[string]any {
 	values := make(map[string]any, len(m.items))
 
+	for i, item := range m.items {
+		if m.taken(i) {
+			values[item.Name] = value(item.Component)
+		}
+	}
+
+	return values
+}
+
+func (m *Form) Sensitive(name string) bool {
+	for _, item := range m.items {
+		if item.Name == name {
+			return isSensitive(item.Component)
+		}
+	}
+
+	return false
+}
+
+func (m *Form) RedactedValues() map[string]any {
+	values := m.Values()
+
 	for _, item := range m.items {
-		values[item.Name] = m.Value(item.Name)
+		if _, ok := values[item.Name]; ok && isSensitive(item.Component) {
+			values[item.Name] = RedactedValue
+		}
 	}
 
 	return values
 }
 
 func (m *Form) SetSelectedIndex(index int) *Form {
-	m.state.selectedIndex = index
+	m.visit(index)
 
 	if m.state.step < m.state.selectedIndex {
 		m.state.step = m.state.selectedIndex
@@ -222,8 +367,360 @@ func (m *Form) SetStep(step int) *Form {
 	return m
 }
 
+func (m *Form) SetValidateFunc(fn func(values map[string]any) map[string]error) *Form {
+	m.validateFunc = fn
+
+	return m
+}
+
+func (m *Form) SetValidateOnNext(validateOnNext bool) *Form {
+	m.validateOnNext = validateOnNext
+
+	return m
+}
+
+func (m *Form) SetSubmitFunc(fn func(values map[string]any) error) *Form {
+	m.submitFunc = fn
+
+	return m
+}
+
+func (m *Form) SetKeyMap(keyMap FormKeyMap) *Form {
+	m.keyMap = keyMap
+	m.checkKeys()
+
+	return m
+}
+
+func (m *Form) SetTheme(theme component.Theme) *Form {
+	m.style = component.Restyle(m.style, NewFormStyle(theme), m.overrides)
+
+	for i, item := range m.items {
+		m.items[i].Component, _ = item.Component.Update(component.ThemeMsg{Theme: theme})
+	}
+
+	return m
+}
+
+func (m *Form) SetErrorStyle(style lipgloss.Style) *Form {
+	m.style.Error = style
+	m.overrides["Error"] = true
+
+	return m
+}
+
 func (m *Form) Completed() bool {
-	return m.state.completed
+	return m.state.status == FormStatusSubmitted
+}
+
+func (m *Form) Cancelled() bool {
+	return m.state.status == FormStatusCancelled
+}
+
+func (m *Form) Status() FormStatus {
+	return m.state.status
+}
+
+func (m *Form) SubmitError() error {
+	return m.state.submitErr
+}
+
+func (m *Form) Cancel() tea.Cmd {
+	m.state.status = FormStatusCancelled
+
+	return func() tea.Msg {
+		return FormCancelledMsg{}
+	}
+}
+
+func (m *Form) Reopen() *Form {
+	m.state.status = FormStatusEditing
+	m.state.submitErr = nil
+
+	return m
+}
+
+func (m *Form) prev() {
+	for m.state.position > 0 {
+		m.state.position--
+
+		if !isSkip(m.items[m.state.path[m.state.position]].Component) {
+			m.state.selectedIndex = m.state.path[m.state.position]
+
+			return
+		}
+	}
+
+	for i := m.state.selectedIndex - 1; i >= 0; i-- {
+		if !isSkip(m.items[i].Component) {
+			m.visit(i)
+
+			break
+		}
+	}
+}
+
+func (m *Form) next() tea.Cmd {
+	if withValidation, ok := withValidation(m.items[m.state.selectedIndex].Component); ok {
+		if !withValidation.Validate() {
+			return nil
+		}
+	}
+
+	if withAsyncValidation, ok := withAsyncValidation(m.items[m.state.selectedIndex].Component); ok {
+		cmd := withAsyncValidation.ValidateCmd()
+
+		if withAsyncValidation.Validating() {
+			m.state.validating = true
+
+			return cmd
+		}
+	}
+
+	m.state.submitErr = nil
+
+	if err := m.branchError(m.state.selectedIndex); err != nil {
+		m.state.submitErr = fmt.Errorf("%s: %w", m.items[m.state.selectedIndex].Name, err)
+
+		return nil
+	}
+
+	if (m.validateOnNext || m.pageEnd(m.state.selectedIndex)) && !m.validate(m.state.selectedIndex) {
+		return nil
+	}
+
+	following, passed := m.forward(m.state.selectedIndex)
+
+	for _, index := range passed {
+		m.advance(index)
+	}
+
+	if following == len(m.items) {
+		if !m.validate(len(m.items) - 1) {
+			return nil
+		}
+
+		return m.submit()
+	}
+
+	m.advance(following)
+
+	if m.state.step < m.state.selectedIndex {
+		m.state.step = m.state.selectedIndex
+	}
+
+	return nil
+}
+
+func (m *Form) submit() tea.Cmd {
+	if m.submitFunc == nil {
+		return m.submitted(nil)
+	}
+
+	id := m.id
+	values := m.Values()
+	submitFunc := m.submitFunc
+
+	m.state.status = FormStatusSubmitting
+	m.state.submitErr = nil
+
+	return tea.Batch(
+		func() tea.Msg {
+			return formSubmitMsg{id: id, err: submitFunc(values)}
+		},
+		m.spinner.Tick,
+	)
+}
+
+func (m *Form) submitted(err error) tea.Cmd {
+	if err == nil {
+		err = m.decodeTarget()
+	}
+
+	if err != nil {
+		m.state.status = FormStatusEditing
+		m.state.submitErr = err
+
+		return nil
+	}
+
+	values := m.Values()
+
+	m.state.status = FormStatusSubmitted
+	m.state.submitErr = nil
+
+	return func() tea.Msg {
+		return FormSubmittedMsg{Values: values}
+	}
+}
+
+func (m *Form) validate(until int) bool {
+	if m.validateFunc == nil {
+		return true
+	}
+
+	for name := range m.state.errors {
+		for _, item := range m.items {
+			if item.Name == name {
+				if withError, ok := withError(item.Component); ok {
+					withError.SetError(nil)
+				}
+			}
+		}
+	}
+
+	m.state.errors = map[string]error{}
+	m.state.submitErr = nil
+
+	errs := m.validateFunc(m.Values())
+	unfocusable := []error{}
+	first := -1
+
+	for i, item := range m.items {
+		if i > until {
+			break
+		}
+
+		if !m.taken(i) {
+			continue
+		}
+
+		if withHide, ok := withHide(item.Component); ok && withHide.Hide() {
+			continue
+		}
+
+		if err := errs[item.Name]; err != nil {
+			m.state.errors[item.Name] = err
+
+			if withError, ok := withError(item.Component); ok {
+				withError.SetError(err)
+			}
+
+			if isSkip(item.Component) {
+				unfocusable = append(unfocusable, fmt.Errorf("%s: %w", item.Name, err))
+			} else if first < 0 {
+				first = i
+			}
+
+			delete(m.state.prefilled, item.Name)
+		}
+	}
+
+	if len(m.state.errors) == 0 {
+		return true
+	}
+
+	if first >= 0 {
+		m.visit(first)
+	} else {
+		m.state.submitErr = errors.Join(unfocusable...)
+	}
+
+	return false
+}
+
+func (m *Form) following(index int) int {
+	following, _ := m.forward(index)
+
+	return following
+}
+
+func (m *Form) forward(index int) (int, []int) {
+	passed := []int{}
+
+	for i := m.start(index); i < len(m.items); {
+		switch {
+		case isSkip(m.items[i].Component):
+			i++
+		case m.pending(i):
+			passed = append(passed, i)
+			i = m.start(i)
+		default:
+			return i, passed
+		}
+	}
+
+	return len(m.items), passed
+}
+
+func (m *Form) start(index int) int {
+	if withNext, ok := withNext(m.items[index].Component); ok {
+		if name := withNext.Next(); name != "" {
+			for i, item := range m.items {
+				if item.Name == name {
+					return i
+				}
+			}
+		}
+	}
+
+	return index + 1
+}
+
+func (m *Form) branchError(index int) error {
+	if withNext, ok := withNext(m.items[index].Component); ok {
+		name := withNext.Next()
+
+		if name != "" && !slices.ContainsFunc(m.items, func(item FormItem) bool { return item.Name == name }) {
+			return fmt.Errorf("branch target %q does not exist", name)
+		}
+	}
+
+	return nil
+}
+
+func (m *Form) advance(index int) {
+	m.state.selectedIndex = index
+
+	if m.state.position+1 < len(m.state.path) && m.state.path[m.state.position+1] == index {
+		m.state.position++
+
+		return
+	}
+
+	m.state.path = append(m.state.path[:m.state.position+1], index)
+	m.state.position++
+}
+
+func (m *Form) visit(index int) {
+	m.state.selectedIndex = index
+
+	for i, visited := range m.state.path {
+		if visited == index {
+			m.state.position = i
+
+			return
+		}
+	}
+
+	m.state.path = append(m.state.path[:m.state.position+1], index)
+	m.state.position = len(m.state.path) - 1
+}
+
+func (m *Form) pending(index int) bool {
+	return m.state.prefilled[m.items[index].Name] && !slices.Contains(m.state.path, index)
+}
+
+func (m *Form) branching() bool {
+	for _, item := range m.items {
+		if _, ok := withNext(item.Component); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Form) shown(index int) bool {
+	if m.branching() {
+		return slices.Contains(m.state.path, index)
+	}
+
+	return index <= m.state.step
+}
+
+func (m *Form) taken(index int) bool {
+	return !m.branching() || slices.Contains(m.state.path, index)
 }
 
 func (m *Form) initItems() tea.Cmd {
@@ -236,6 +733,20 @@ func (m *Form) initItems() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+func (m *Form) resizeItems(msg tea.WindowSizeMsg) tea.Cmd {
+	cmds := make([]tea.Cmd, len(m.items))
+
+	for i, item := range m.items {
+		var cmd tea.Cmd
+
+		item.Component, cmd = item.Component.Update(msg)
+
+		cmds[i] = cmd
+	}
+
+	return tea.Batch(cmds...)
+}
+
 func (m *Form) updateItems() tea.Cmd {
 	cmds := make([]tea.Cmd, len(m.items))
 
@@ -250,6 +761,26 @@ func (m *Form) updateItems() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+func value(m component.Component) any {
+	if withTypedValue, ok := withTypedValue(m); ok {
+		if value, err := withTypedValue.TypedValue(); err == nil {
+			return value
+		} else {
+			return nil
+		}
+	}
+
+	if withValues, ok := withValues(m); ok {
+		return withValues.Values()
+	}
+
+	if withValue, ok := withValue(m); ok {
+		return withValue.Value()
+	}
+
+	return nil
+}
+
 func withKeys(m component.Component) (WithKeys, bool) {
 	if m, ok := m.(WithKeys); ok {
 		return m, ok
@@ -262,6 +793,18 @@ func withKeys(m component.Component) (WithKeys, bool) {
 	return nil, false
 }
 
+func withNext(m component.Component) (WithNext, bool) {
+	if m, ok := m.(WithNext); ok {
+		return m, ok
+	}
+
+	if m, ok := m.(component.WithChild); ok {
+		return withNext(m.Child())
+	}
+
+	return nil, false
+}
+
 func withSkip(m component.Component) (WithSkip, bool) {
 	if m, ok := m.(WithSkip); ok {
 		return m, ok
@@ -298,6 +841,26 @@ func isSkip(m component.Component) bool {
 	return false
 }
 
+func withSensitive(m component.Component) (WithSensitive, bool) {
+	if m, ok := m.(WithSensitive); ok {
+		return m, ok
+	}
+
+	if m, ok := m.(component.WithChild); ok {
+		return withSensitive(m.Child())
+	}
+
+	return nil, false
+}
+
+func isSensitive(m component.Component) bool {
+	if withSensitive, ok := withSensitive(m); ok {
+		return withSensitive.Sensitive()
+	}
+
+	return false
+}
+
 func withValidation(m component.Component) (WithValidation, bool) {
 	if m, ok := m.(WithValidation); ok {
 		return m, ok
@@ -321,3 +884,63 @@ func withValue(m component.Component) (WithValue, bool) {
 
 	return nil, false
 }
+
+func withValues(m component.Component) (WithValues, bool) {
+	if m, ok := m.(WithValues); ok {
+		return m, ok
+	}
+
+	if m, ok := m.(component.WithChild); ok {
+		return withValues(m.Child())
+	}
+
+	return nil, false
+}
+
+func withTypedValue(m component.Component) (WithTypedValue, bool) {
+	if m, ok := m.(WithTypedValue); ok {
+		return m, ok
+	}
+
+	if m, ok := m.(component.WithChild); ok {
+		return withTypedValue(m.Child())
+	}
+
+	return nil, false
+}
+
+func withCapture(m component.Component) (WithCapture, bool) {
+	if m, ok := m.(WithCapture); ok {
+		return m, ok
+	}
+
+	if m, ok := m.(component.WithChild); ok {
+		return withCapture(m.Child())
+	}
+
+	return nil, false
+}
+
+func withAsyncValidation(m component.Component) (WithAsyncValidation, bool) {
+	if m, ok := m.(WithAsyncValidation); ok {
+		return m, ok
+	}
+
+	if m, ok := m.(component.WithChild); ok {
+		return withAsyncValidation(m.Child())
+	}
+
+	return nil, false
+}
+
+func withError(m component.Component) (WithError, bool) {
+	if m, ok := m.(WithError); ok {
+		return m, ok
+	}
+
+	if m, ok := m.(component.WithChild); ok {
+		return withError(m.Child())
+	}
+
+	return nil, false
+}
@@ -0,0 +1,116 @@
+package form
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Group struct {
+	Title       string
+	Description string
+	Items       []FormItem
+}
+
+func NewGroupedForm(groups []Group) *Form {
+	items := []FormItem{}
+
+	for _, group := range groups {
+		items = append(items, group.Items...)
+	}
+
+	m := NewForm(items)
+	m.groups = groups
+
+	return m
+}
+
+func (m *Form) Page() int {
+	return m.group(m.state.selectedIndex)
+}
+
+func (m *Form) Pages() int {
+	return len(m.groups)
+}
+
+func (m *Form) Group() *Group {
+	if len(m.groups) == 0 {
+		return nil
+	}
+
+	return &m.groups[m.Page()]
+}
+
+func (m *Form) SetProgressFunc(fn func(page int, pages int) string) *Form {
+	m.progressFunc = fn
+
+	return m
+}
+
+func (m *Form) SetProgressStyle(style lipgloss.Style) *Form {
+	m.style.Progress = style
+	m.overrides["Progress"] = true
+
+	return m
+}
+
+func (m *Form) SetTitleStyle(style lipgloss.Style) *Form {
+	m.style.Title = style
+	m.overrides["Title"] = true
+
+	return m
+}
+
+func (m *Form) SetDescriptionStyle(style lipgloss.Style) *Form {
+	m.style.Description = style
+	m.overrides["Description"] = true
+
+	return m
+}
+
+func (m *Form) group(index int) int {
+	for i, group := range m.groups {
+		if index < len(group.Items) {
+			return i
+		}
+
+		index -= len(group.Items)
+	}
+
+	return max(len(m.groups)-1, 0)
+}
+
+func (m *Form) groupView() string {
+	if len(m.groups) == 0 {
+		return ""
+	}
+
+	page := m.Page()
+	group := m.groups[page]
+	lines := []string{m.style.Progress.Render(m.progressFunc(page+1, len(m.groups)))}
+
+	if group.Title != "" {
+		lines = append(lines, m.style.Title.Render(group.Title))
+	}
+
+	if group.Description != "" {
+		lines = append(lines, m.style.Description.Render(group.Description))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func progress(page int, pages int) string {
+	return fmt.Sprintf("Step %d of %d", page, pages)
+}
+
+func (m *Form) pageEnd(index int) bool {
+	if len(m.groups) == 0 {
+		return false
+	}
+
+	following := m.following(index)
+
+	return following < len(m.items) && m.group(following) != m.group(index)
+}
@@ -1,7 +1,13 @@
 package form
 
 import (
+	"strings"
+	"time"
+	"unicode"
+
 	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -16,45 +22,137 @@ type InputStyle struct {
 	PlaceholderBase  lipgloss.Style
 	PlaceholderFocus lipgloss.Style
 	Cursor           lipgloss.Style
+	SuggestionBase   lipgloss.Style
+	SuggestionFocus  lipgloss.Style
 }
 
 type InputState struct {
-	focus bool
+	focus      bool
+	seq        int
+	validating bool
+	validated  bool
+	asyncErr   error
+	revealed   bool
+	loading    bool
+	loaded     bool
 }
 
 type Input struct {
-	validateFunc func(string) error
-	err          error
-	input        textinput.Model
-	style        InputStyle
-	state        InputState
+	id                int
+	validateFunc      func(string) error
+	asyncValidateFunc func(string) tea.Cmd
+	debounce          time.Duration
+	secret            bool
+	sensitive         bool
+	acceptFunc        func(rune) bool
+	formatMask        string
+	rawValue          bool
+	suggestionsFunc   func() []string
+	suggestionLimit   int
+	err               error
+	theme             *component.Theme
+	input             textinput.Model
+	spinner           spinner.Model
+	keyMap            InputKeyMap
+	style             InputStyle
+	overrides         map[string]bool
+	state             InputState
+}
+
+type InputKeyMap struct {
+	Reveal           key.Binding
+	AcceptSuggestion key.Binding
+	NextSuggestion   key.Binding
+	PrevSuggestion   key.Binding
+}
+
+type inputValidationMsg struct {
+	id  int
+	seq int
+	err error
+}
+
+type inputDebounceMsg struct {
+	id  int
+	seq int
+}
+
+type inputSuggestionsMsg struct {
+	id          int
+	suggestions []string
 }
 
 var (
-	InputDefaultStyle = InputStyle{
-		TextBase:         lipgloss.NewStyle().Foreground(lipgloss.Color("15")),
-		TextFocus:        lipgloss.NewStyle().Foreground(lipgloss.Color("15")),
-		PromptBase:       lipgloss.NewStyle().Foreground(lipgloss.Color("15")),
-		PromptFocus:      lipgloss.NewStyle().Foreground(lipgloss.Color("15")),
-		PlaceholderBase:  lipgloss.NewStyle().Foreground(lipgloss.Color("8")),
-		PlaceholderFocus: lipgloss.NewStyle().Foreground(lipgloss.Color("8")),
-		Cursor:           lipgloss.NewStyle().Foreground(lipgloss.Color("33")),
-		Error:            lipgloss.NewStyle().Foreground(lipgloss.Color("9")),
+	InputDefaultStyle  = NewInputStyle(component.DefaultTheme)
+	InputDefaultKeyMap = InputKeyMap{
+		Reveal: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "Reveal/hide"),
+		),
+		AcceptSuggestion: key.NewBinding(
+			key.WithKeys("tab", "right"),
+			key.WithHelp("tab/right", "Accept suggestion"),
+		),
+		NextSuggestion: key.NewBinding(
+			key.WithKeys("down", "ctrl+n"),
+			key.WithHelp("down", "Next suggestion"),
+		),
+		PrevSuggestion: key.NewBinding(
+			key.WithKeys("up", "ctrl+p"),
+			key.WithHelp("up", "Previous suggestion"),
+		),
 	}
 )
 
+func NewInputStyle(theme component.Theme) InputStyle {
+	return InputStyle{
+		TextBase:         lipgloss.NewStyle().Foreground(theme.Foreground),
+		TextFocus:        lipgloss.NewStyle().Foreground(theme.Foreground),
+		PromptBase:       lipgloss.NewStyle().Foreground(theme.Foreground),
+		PromptFocus:      lipgloss.NewStyle().Foreground(theme.Foreground),
+		PlaceholderBase:  lipgloss.NewStyle().Foreground(theme.Muted),
+		PlaceholderFocus: lipgloss.NewStyle().Foreground(theme.Muted),
+		Cursor:           lipgloss.NewStyle().Foreground(theme.Accent),
+		Error:            lipgloss.NewStyle().Foreground(theme.Error),
+		SuggestionBase:   lipgloss.NewStyle().Foreground(theme.Muted).PaddingLeft(2),
+		SuggestionFocus:  lipgloss.NewStyle().Foreground(theme.Accent).PaddingLeft(2),
+	}
+}
+
 func NewInput() *Input {
 	m := &Input{
-		validateFunc: func(string) error { return nil },
-		err:          nil,
-		input:        textinput.New(),
-		style:        InputDefaultStyle,
+		id:                nextID(),
+		validateFunc:      func(string) error { return nil },
+		asyncValidateFunc: nil,
+		debounce:          0,
+		secret:            false,
+		sensitive:         false,
+		acceptFunc:        nil,
+		formatMask:        "",
+		rawValue:          false,
+		suggestionsFunc:   nil,
+		suggestionLimit:   5,
+		err:               nil,
+		theme:             nil,
+		input:             textinput.New(),
+		spinner:           spinner.New(),
+		keyMap:            InputDefaultKeyMap,
+		style:             InputDefaultStyle,
+		overrides:         map[string]bool{},
 		state: InputState{
-			focus: false,
+			focus:      false,
+			seq:        0,
+			validating: false,
+			validated:  false,
+			asyncErr:   nil,
+			revealed:   false,
+			loading:    false,
+			loaded:     false,
 		},
 	}
 
 	m.input.TextStyle = m.style.TextBase
+	m.updateKeyMap()
 
 	return m
 }
@@ -66,16 +164,71 @@ func (m *Input) Init() tea.Cmd {
 func (m *Input) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	cmds := []tea.Cmd{}
 
+	value := m.input.Value()
+
 	switch typedMsg := msg.(type) {
 	case component.FocusMsg:
 		m.state.focus = typedMsg.Focus
 		m.updateStyle()
 
 		if m.state.focus {
-			cmds = append(cmds, m.input.Focus())
+			cmds = append(cmds, m.input.Focus(), m.loadSuggestions())
 		} else {
 			m.input.Blur()
+
+			if m.state.validating {
+				m.invalidate()
+			}
+
+			m.state.revealed = false
+			m.state.loading = false
+			m.updateEchoMode()
+		}
+	case component.ThemeMsg:
+		if m.theme == nil {
+			m.style = component.Restyle(m.style, NewInputStyle(typedMsg.Theme), m.overrides)
+			m.updateStyle()
+		}
+	case inputSuggestionsMsg:
+		if typedMsg.id == m.id && m.state.loading {
+			m.state.loading = false
+			m.state.loaded = true
+			m.input.SetSuggestions(typedMsg.suggestions)
+		}
+
+		return m, nil
+	case tea.KeyMsg:
+		if m.secret && key.Matches(typedMsg, m.keyMap.Reveal) {
+			m.state.revealed = !m.state.revealed
+			m.updateEchoMode()
+
+			return m, nil
+		}
+
+		if typedMsg.Type == tea.KeyRunes {
+			typedMsg.Runes = m.accept(typedMsg.Runes)
+
+			if len(typedMsg.Runes) == 0 {
+				return m, nil
+			}
+
+			msg = typedMsg
+		}
+	case inputValidationMsg:
+		if typedMsg.id == m.id && typedMsg.seq == m.state.seq {
+			m.state.validating = false
+			m.state.validated = true
+			m.state.asyncErr = typedMsg.err
+			m.err = typedMsg.err
+		}
+
+		return m, nil
+	case inputDebounceMsg:
+		if typedMsg.id == m.id && typedMsg.seq == m.state.seq {
+			cmds = append(cmds, m.ValidateCmd())
 		}
+
+		return m, tea.Batch(cmds...)
 	}
 
 	var cmd tea.Cmd
@@ -84,13 +237,65 @@ func (m *Input) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	cmds = append(cmds, cmd)
 
+	if m.formatMask != "" && m.input.Value() != value {
+		if masked, _ := m.format(m.input.Value()); masked != m.input.Value() {
+			position := m.input.Position() + len([]rune(masked)) - len([]rune(m.input.Value()))
+
+			m.input.SetValue(masked)
+			m.input.SetCursor(position)
+		}
+	}
+
+	if m.input.Value() != value {
+		m.invalidate()
+
+		if m.asyncValidateFunc != nil && m.debounce > 0 {
+			id := m.id
+			seq := m.state.seq
+
+			cmds = append(cmds, tea.Tick(m.debounce, func(time.Time) tea.Msg {
+				return inputDebounceMsg{id: id, seq: seq}
+			}))
+		}
+	}
+
+	if m.state.validating {
+		m.spinner, cmd = m.spinner.Update(msg)
+
+		cmds = append(cmds, cmd)
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
 func (m *Input) View() string {
 	var s string
 
-	s += m.input.View()
+	input := m.input
+
+	if component.NoColor() && !m.state.focus {
+		input.Prompt = strings.Repeat(" ", lipgloss.Width(input.Prompt))
+	}
+
+	s += input.View()
+
+	if m.state.validating {
+		s += " " + m.spinner.View()
+	}
+
+	if m.state.focus {
+		current := m.input.CurrentSuggestion()
+
+		for _, suggestion := range m.suggestions() {
+			if suggestion == current && component.NoColor() {
+				s += "\n" + m.style.SuggestionFocus.UnsetPadding().Render("> "+suggestion)
+			} else if suggestion == current {
+				s += "\n" + m.style.SuggestionFocus.Render(suggestion)
+			} else {
+				s += "\n" + m.style.SuggestionBase.Render(suggestion)
+			}
+		}
+	}
 
 	if m.err != nil {
 		s += "\n" + m.style.Error.Render(m.err.Error())
@@ -99,20 +304,92 @@ func (m *Input) View() string {
 	return s
 }
 
+func (m *Input) Captures() []key.Binding {
+	if m.canAcceptSuggestion() {
+		return []key.Binding{m.keyMap.AcceptSuggestion}
+	}
+
+	return []key.Binding{}
+}
+
+func (m *Input) Keys() []key.Binding {
+	keys := []key.Binding{}
+
+	if len(m.suggestions()) > 0 {
+		keys = append(keys, m.keyMap.AcceptSuggestion, m.keyMap.NextSuggestion, m.keyMap.PrevSuggestion)
+	}
+
+	if m.secret {
+		keys = append(keys, m.keyMap.Reveal)
+	}
+
+	return keys
+}
+
 func (m *Input) Value() string {
+	if m.formatMask != "" && m.rawValue {
+		_, raw := m.format(m.input.Value())
+
+		return raw
+	}
+
 	return m.input.Value()
 }
 
+func (m *Input) Sensitive() bool {
+	return m.secret || m.sensitive
+}
+
 func (m *Input) Validate() bool {
-	m.err = m.validateFunc(m.input.Value())
+	m.err = m.validateFunc(m.Value())
+
+	if m.err == nil && m.state.validated {
+		m.err = m.state.asyncErr
+	}
 
 	return m.err == nil
 }
 
+func (m *Input) ValidateCmd() tea.Cmd {
+	if m.asyncValidateFunc == nil || m.state.validating || m.state.validated {
+		return nil
+	}
+
+	cmd := m.asyncValidateFunc(m.Value())
+
+	if cmd == nil {
+		m.state.validated = true
+
+		return nil
+	}
+
+	id := m.id
+	seq := m.state.seq
+
+	m.state.validating = true
+
+	return tea.Batch(
+		func() tea.Msg {
+			err, _ := cmd().(error)
+
+			return inputValidationMsg{id: id, seq: seq, err: err}
+		},
+		m.spinner.Tick,
+	)
+}
+
+func (m *Input) Validating() bool {
+	return m.state.validating
+}
+
 func (m *Input) Error() error {
 	return m.err
 }
 
+func (m *Input) SetError(err error) {
+	m.err = err
+}
+
 func (m *Input) SetPlaceholder(placeholder string) *Input {
 	m.input.Placeholder = placeholder
 
@@ -125,60 +402,292 @@ func (m *Input) SetValidateFunc(fn func(string) error) *Input {
 	return m
 }
 
+func (m *Input) SetAsyncValidateFunc(fn func(string) tea.Cmd) *Input {
+	m.asyncValidateFunc = fn
+	m.invalidate()
+
+	return m
+}
+
+func (m *Input) SetValidateDebounce(debounce time.Duration) *Input {
+	m.debounce = debounce
+
+	return m
+}
+
 func (m *Input) SetValue(value string) *Input {
+	if m.formatMask != "" {
+		value, _ = m.format(value)
+	}
+
 	m.input.SetValue(value)
+	m.invalidate()
+
+	return m
+}
+
+func (m *Input) SetSecret(secret bool) *Input {
+	m.secret = secret
+	m.state.revealed = false
+	m.updateEchoMode()
+
+	return m
+}
+
+func (m *Input) SetMask(mask rune) *Input {
+	m.input.EchoCharacter = mask
+
+	return m
+}
+
+func (m *Input) SetAcceptFunc(fn func(rune) bool) *Input {
+	m.acceptFunc = fn
+
+	return m
+}
+
+func (m *Input) SetFormatMask(mask string) *Input {
+	m.formatMask = mask
+
+	if m.input.Placeholder == "" {
+		m.input.Placeholder = mask
+	}
+
+	if mask != "" {
+		value, _ := m.format(m.input.Value())
+
+		m.input.SetValue(value)
+	}
+
+	return m
+}
+
+func (m *Input) SetRawValue(raw bool) *Input {
+	m.rawValue = raw
+
+	return m
+}
+
+func (m *Input) SetSuggestions(suggestions []string) *Input {
+	m.input.ShowSuggestions = true
+	m.input.SetSuggestions(suggestions)
+
+	return m
+}
+
+func (m *Input) SetSuggestionsFunc(fn func() []string) *Input {
+	m.input.ShowSuggestions = true
+	m.suggestionsFunc = fn
+	m.state.loaded = false
+
+	return m
+}
+
+func (m *Input) SetSuggestionLimit(limit int) *Input {
+	m.suggestionLimit = limit
+
+	return m
+}
+
+func (m *Input) SetSensitive(sensitive bool) *Input {
+	m.sensitive = sensitive
+
+	return m
+}
+
+func (m *Input) SetKeyMap(keyMap InputKeyMap) *Input {
+	m.keyMap = keyMap
+	m.updateKeyMap()
+
+	return m
+}
+
+func (m *Input) SetTheme(theme component.Theme) *Input {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewInputStyle(theme), m.overrides)
+	m.updateStyle()
 
 	return m
 }
 
 func (m *Input) SetTextBaseStyle(style lipgloss.Style) *Input {
 	m.style.TextBase = style
+	m.overrides["TextBase"] = true
 
 	return m
 }
 
 func (m *Input) SetTextFocusStyle(style lipgloss.Style) *Input {
 	m.style.TextFocus = style
+	m.overrides["TextFocus"] = true
 
 	return m
 }
 
 func (m *Input) SetPromptBaseStyle(style lipgloss.Style) *Input {
 	m.style.PromptBase = style
+	m.overrides["PromptBase"] = true
 
 	return m
 }
 
 func (m *Input) SetPromptFocusStyle(style lipgloss.Style) *Input {
 	m.style.PromptFocus = style
+	m.overrides["PromptFocus"] = true
 
 	return m
 }
 
 func (m *Input) SetPlaceholderBaseStyle(style lipgloss.Style) *Input {
 	m.style.PlaceholderBase = style
+	m.overrides["PlaceholderBase"] = true
 
 	return m
 }
 
 func (m *Input) SetPlaceholderFocusStyle(style lipgloss.Style) *Input {
 	m.style.PlaceholderFocus = style
+	m.overrides["PlaceholderFocus"] = true
 
 	return m
 }
 
 func (m *Input) SetCursorStyle(style lipgloss.Style) *Input {
 	m.style.Cursor = style
+	m.overrides["Cursor"] = true
 
 	return m
 }
 
 func (m *Input) SetErrorStyle(style lipgloss.Style) *Input {
 	m.style.Error = style
+	m.overrides["Error"] = true
 
 	return m
 }
 
+func (m *Input) SetSuggestionBaseStyle(style lipgloss.Style) *Input {
+	m.style.SuggestionBase = style
+	m.overrides["SuggestionBase"] = true
+
+	return m
+}
+
+func (m *Input) SetSuggestionFocusStyle(style lipgloss.Style) *Input {
+	m.style.SuggestionFocus = style
+	m.overrides["SuggestionFocus"] = true
+
+	return m
+}
+
+func (m *Input) loadSuggestions() tea.Cmd {
+	if m.suggestionsFunc == nil || m.state.loading || m.state.loaded {
+		return nil
+	}
+
+	id := m.id
+	suggestionsFunc := m.suggestionsFunc
+
+	m.state.loading = true
+
+	return func() tea.Msg {
+		return inputSuggestionsMsg{id: id, suggestions: suggestionsFunc()}
+	}
+}
+
+func (m *Input) suggestions() []string {
+	suggestions := []string{}
+	value := strings.ToLower(m.input.Value())
+
+	if value == "" || !m.input.ShowSuggestions {
+		return suggestions
+	}
+
+	for _, suggestion := range m.input.AvailableSuggestions() {
+		if len(suggestions) >= m.suggestionLimit {
+			break
+		}
+
+		if strings.HasPrefix(strings.ToLower(suggestion), value) && !strings.EqualFold(suggestion, m.input.Value()) {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	return suggestions
+}
+
+func (m *Input) canAcceptSuggestion() bool {
+	suggestion := m.input.CurrentSuggestion()
+
+	return m.input.ShowSuggestions && len([]rune(suggestion)) > len([]rune(m.input.Value()))
+}
+
+func (m *Input) accept(runes []rune) []rune {
+	accepted := []rune{}
+
+	for _, r := range runes {
+		if m.acceptFunc == nil || m.acceptFunc(r) || (m.formatMask != "" && !isMaskPlaceholder(r) && strings.ContainsRune(m.formatMask, r)) {
+			accepted = append(accepted, r)
+		}
+	}
+
+	return accepted
+}
+
+func (m *Input) format(value string) (string, string) {
+	mask := []rune(m.formatMask)
+	masked := []rune{}
+	raw := []rune{}
+	position := 0
+
+	for _, r := range value {
+		next := position
+
+		for next < len(mask) && !isMaskPlaceholder(mask[next]) && mask[next] != r {
+			next++
+		}
+
+		switch {
+		case next >= len(mask):
+		case !isMaskPlaceholder(mask[next]):
+			masked = append(masked, mask[position:next+1]...)
+			position = next + 1
+		case maskAccepts(mask[next], r):
+			masked = append(append(masked, mask[position:next]...), r)
+			raw = append(raw, r)
+			position = next + 1
+		default:
+			if literal := nextMaskLiteral(mask, next, r); literal >= 0 {
+				masked = append(append(masked, mask[position:next]...), r)
+				position = literal + 1
+			}
+		}
+	}
+
+	return string(masked), string(raw)
+}
+
+func (m *Input) invalidate() {
+	m.state.seq++
+	m.state.validating = false
+	m.state.validated = false
+	m.state.asyncErr = nil
+}
+
+func (m *Input) updateKeyMap() {
+	m.input.KeyMap.AcceptSuggestion = m.keyMap.AcceptSuggestion
+	m.input.KeyMap.NextSuggestion = m.keyMap.NextSuggestion
+	m.input.KeyMap.PrevSuggestion = m.keyMap.PrevSuggestion
+}
+
+func (m *Input) updateEchoMode() {
+	if m.secret && !m.state.revealed {
+		m.input.EchoMode = textinput.EchoPassword
+	} else {
+		m.input.EchoMode = textinput.EchoNormal
+	}
+}
+
 func (m *Input) updateStyle() {
 	if m.state.focus {
 		m.input.TextStyle = m.style.TextFocus
@@ -191,3 +700,57 @@ func (m *Input) updateStyle() {
 	}
 	m.input.Cursor.Style = m.style.Cursor
 }
+
+func AcceptDigits(r rune) bool {
+	return unicode.IsDigit(r)
+}
+
+func AcceptLetters(r rune) bool {
+	return unicode.IsLetter(r)
+}
+
+func AcceptAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func AcceptHex(r rune) bool {
+	return unicode.Is(unicode.ASCII_Hex_Digit, r)
+}
+
+func isMaskPlaceholder(r rune) bool {
+	switch r {
+	case '#', 'Y', 'M', 'D', 'A', 'X', '*':
+		return true
+	}
+
+	return false
+}
+
+func maskAccepts(placeholder rune, r rune) bool {
+	switch placeholder {
+	case '#', 'Y', 'M', 'D':
+		return AcceptDigits(r)
+	case 'A':
+		return AcceptLetters(r)
+	case 'X':
+		return AcceptHex(r)
+	case '*':
+		return AcceptAlphanumeric(r)
+	}
+
+	return false
+}
+
+func nextMaskLiteral(mask []rune, position int, r rune) int {
+	for i := position; i < len(mask); i++ {
+		if !isMaskPlaceholder(mask[i]) {
+			if mask[i] == r {
+				return i
+			}
+
+			return -1
+		}
+	}
+
+	return -1
+}
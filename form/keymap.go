@@ -0,0 +1,320 @@
+package form
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type KeyMaps struct {
+	Form        FormKeyMap
+	Input       InputKeyMap
+	Number      NumberKeyMap
+	Select      SelectKeyMap
+	MultiSelect MultiSelectKeyMap
+	Confirm     ConfirmKeyMap
+	TextArea    TextAreaKeyMap
+	FilePicker  FilePickerKeyMap
+	DatePicker  DatePickerKeyMap
+}
+
+type KeyConflict struct {
+	Name   string
+	Key    string
+	Form   key.Binding
+	Item   key.Binding
+	Filter bool
+}
+
+type KeyConflictError struct {
+	Conflicts []KeyConflict
+}
+
+var (
+	FormVimKeyMap = FormKeyMap{
+		Prev: key.NewBinding(
+			key.WithKeys("shift+tab", "ctrl+o"),
+			key.WithHelp("shift+tab/ctrl+o", "Previous"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("enter", "tab", "ctrl+j"),
+			key.WithHelp("enter/ctrl+j", "Next"),
+		),
+		Cancel: FormDefaultKeyMap.Cancel,
+	}
+	FormEmacsKeyMap = FormKeyMap{
+		Prev: FormDefaultKeyMap.Prev,
+		Next: FormDefaultKeyMap.Next,
+		Cancel: key.NewBinding(
+			key.WithKeys("esc", "ctrl+g"),
+			key.WithHelp("esc/ctrl+g", "Cancel"),
+		),
+	}
+	SelectVimKeyMap = SelectKeyMap{
+		Prev: key.NewBinding(
+			key.WithKeys("left", "up", "h", "k"),
+			key.WithHelp("h/k", "Previous selection"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("right", "down", "l", "j"),
+			key.WithHelp("l/j", "Next selection"),
+		),
+		PageUp: key.NewBinding(
+			key.WithKeys("pgup", "ctrl+b"),
+			key.WithHelp("ctrl+b", "Previous page"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("pgdown", "ctrl+f"),
+			key.WithHelp("ctrl+f", "Next page"),
+		),
+		Home: key.NewBinding(
+			key.WithKeys("home", "g"),
+			key.WithHelp("g", "First selection"),
+		),
+		End: key.NewBinding(
+			key.WithKeys("end", "G"),
+			key.WithHelp("G", "Last selection"),
+		),
+		ClearFilter: SelectDefaultKeyMap.ClearFilter,
+	}
+	SelectEmacsKeyMap = SelectKeyMap{
+		Prev: key.NewBinding(
+			key.WithKeys("left", "up", "ctrl+b", "ctrl+p"),
+			key.WithHelp("ctrl+b/ctrl+p", "Previous selection"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("right", "down", "ctrl+f", "ctrl+n"),
+			key.WithHelp("ctrl+f/ctrl+n", "Next selection"),
+		),
+		PageUp: key.NewBinding(
+			key.WithKeys("pgup", "alt+v"),
+			key.WithHelp("alt+v", "Previous page"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("pgdown", "ctrl+v"),
+			key.WithHelp("ctrl+v", "Next page"),
+		),
+		Home: key.NewBinding(
+			key.WithKeys("home", "alt+<"),
+			key.WithHelp("alt+<", "First selection"),
+		),
+		End: key.NewBinding(
+			key.WithKeys("end", "alt+>"),
+			key.WithHelp("alt+>", "Last selection"),
+		),
+		ClearFilter: SelectDefaultKeyMap.ClearFilter,
+	}
+	MultiSelectVimKeyMap = MultiSelectKeyMap{
+		Prev: SelectVimKeyMap.Prev,
+		Next: SelectVimKeyMap.Next,
+		Toggle: key.NewBinding(
+			key.WithKeys(" ", "x"),
+			key.WithHelp("space/x", "Toggle selection"),
+		),
+		All: MultiSelectDefaultKeyMap.All,
+	}
+	MultiSelectEmacsKeyMap = MultiSelectKeyMap{
+		Prev:   SelectEmacsKeyMap.Prev,
+		Next:   SelectEmacsKeyMap.Next,
+		Toggle: MultiSelectDefaultKeyMap.Toggle,
+		All:    MultiSelectDefaultKeyMap.All,
+	}
+	ConfirmVimKeyMap = ConfirmKeyMap{
+		Toggle: key.NewBinding(
+			key.WithKeys("left", "right", "h", "l"),
+			key.WithHelp("h/l", "Toggle answer"),
+		),
+		Yes: ConfirmDefaultKeyMap.Yes,
+		No:  ConfirmDefaultKeyMap.No,
+	}
+	ConfirmEmacsKeyMap = ConfirmKeyMap{
+		Toggle: key.NewBinding(
+			key.WithKeys("left", "right", "ctrl+b", "ctrl+f"),
+			key.WithHelp("ctrl+b/ctrl+f", "Toggle answer"),
+		),
+		Yes: ConfirmDefaultKeyMap.Yes,
+		No:  ConfirmDefaultKeyMap.No,
+	}
+	DefaultKeyMaps = KeyMaps{
+		Form:        FormDefaultKeyMap,
+		Input:       InputDefaultKeyMap,
+		Number:      NumberDefaultKeyMap,
+		Select:      SelectDefaultKeyMap,
+		MultiSelect: MultiSelectDefaultKeyMap,
+		Confirm:     ConfirmDefaultKeyMap,
+		TextArea:    TextAreaDefaultKeyMap,
+		FilePicker:  FilePickerDefaultKeyMap,
+		DatePicker:  DatePickerDefaultKeyMap,
+	}
+	VimKeyMaps = KeyMaps{
+		Form:        FormVimKeyMap,
+		Input:       InputDefaultKeyMap,
+		Number:      NumberDefaultKeyMap,
+		Select:      SelectVimKeyMap,
+		MultiSelect: MultiSelectVimKeyMap,
+		Confirm:     ConfirmVimKeyMap,
+		TextArea:    TextAreaDefaultKeyMap,
+		FilePicker:  FilePickerDefaultKeyMap,
+		DatePicker:  DatePickerDefaultKeyMap,
+	}
+	EmacsKeyMaps = KeyMaps{
+		Form:        FormEmacsKeyMap,
+		Input:       InputDefaultKeyMap,
+		Number:      NumberDefaultKeyMap,
+		Select:      SelectEmacsKeyMap,
+		MultiSelect: MultiSelectEmacsKeyMap,
+		Confirm:     ConfirmEmacsKeyMap,
+		TextArea:    TextAreaDefaultKeyMap,
+		FilePicker:  FilePickerDefaultKeyMap,
+		DatePicker:  DatePickerDefaultKeyMap,
+	}
+)
+
+func (c KeyConflict) String() string {
+	if c.Filter {
+		return fmt.Sprintf("%s: %q is bound to %q and cannot be typed into the filter", c.Name, c.Key, c.Item.Help().Desc)
+	}
+
+	return fmt.Sprintf("%s: %q is bound to both %q and form %q", c.Name, c.Key, c.Item.Help().Desc, c.Form.Help().Desc)
+}
+
+func (e *KeyConflictError) Error() string {
+	conflicts := make([]string, len(e.Conflicts))
+
+	for i, conflict := range e.Conflicts {
+		conflicts[i] = conflict.String()
+	}
+
+	return "key conflicts: " + strings.Join(conflicts, "; ")
+}
+
+func (m *Form) SetKeyMaps(keyMaps KeyMaps) *Form {
+	m.keyMap = keyMaps.Form
+
+	for _, item := range m.items {
+		applyKeyMaps(item.Component, keyMaps)
+	}
+
+	m.checkKeys()
+
+	return m
+}
+
+func (m *Form) KeyError() error {
+	return m.keyErr
+}
+
+func (m *Form) KeyConflicts() []KeyConflict {
+	conflicts := []KeyConflict{}
+	formBindings := []key.Binding{m.keyMap.Prev, m.keyMap.Next, m.keyMap.Cancel}
+
+	for _, item := range m.items {
+		withKeys, ok := withKeys(item.Component)
+
+		if !ok {
+			continue
+		}
+
+		captured := []string{}
+
+		if withCapture, ok := withCapture(item.Component); ok {
+			for _, binding := range withCapture.Captures() {
+				captured = append(captured, binding.Keys()...)
+			}
+		}
+
+		for _, itemBinding := range filterBindings(item.Component) {
+			for _, k := range itemBinding.Keys() {
+				if utf8.RuneCountInString(k) == 1 {
+					conflicts = append(conflicts, KeyConflict{
+						Name:   item.Name,
+						Key:    k,
+						Form:   key.Binding{},
+						Item:   itemBinding,
+						Filter: true,
+					})
+				}
+			}
+		}
+
+		for _, itemBinding := range withKeys.Keys() {
+			for _, k := range itemBinding.Keys() {
+				if slices.Contains(captured, k) {
+					continue
+				}
+
+				for _, formBinding := range formBindings {
+					if slices.Contains(formBinding.Keys(), k) {
+						conflicts = append(conflicts, KeyConflict{
+							Name:   item.Name,
+							Key:    k,
+							Form:   formBinding,
+							Item:   itemBinding,
+							Filter: false,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
+
+func (m *Form) checkKeys() {
+	m.keyErr = nil
+
+	if conflicts := m.KeyConflicts(); len(conflicts) > 0 {
+		m.keyErr = &KeyConflictError{Conflicts: conflicts}
+	}
+}
+
+func filterBindings(m component.Component) []key.Binding {
+	if typed, ok := m.(*Select); ok && typed.filterable {
+		return []key.Binding{
+			typed.keyMap.Prev,
+			typed.keyMap.Next,
+			typed.keyMap.PageUp,
+			typed.keyMap.PageDown,
+			typed.keyMap.Home,
+			typed.keyMap.End,
+		}
+	}
+
+	if m, ok := m.(component.WithChild); ok && m.Child() != nil {
+		return filterBindings(m.Child())
+	}
+
+	return nil
+}
+
+func applyKeyMaps(m component.Component, keyMaps KeyMaps) {
+	switch typed := m.(type) {
+	case *Input:
+		typed.SetKeyMap(keyMaps.Input)
+	case *Number:
+		typed.SetKeyMap(keyMaps.Number)
+	case *Select:
+		typed.SetKeyMap(keyMaps.Select)
+	case *MultiSelect:
+		typed.SetKeyMap(keyMaps.MultiSelect)
+	case *Confirm:
+		typed.SetKeyMap(keyMaps.Confirm)
+	case *TextArea:
+		typed.SetKeyMap(keyMaps.TextArea)
+	case *FilePicker:
+		typed.SetKeyMap(keyMaps.FilePicker)
+	case *DatePicker:
+		typed.SetKeyMap(keyMaps.DatePicker)
+	case *Loader:
+		typed.keyMaps = &keyMaps
+	}
+
+	if m, ok := m.(component.WithChild); ok && m.Child() != nil {
+		applyKeyMaps(m.Child(), keyMaps)
+	}
+}
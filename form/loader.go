@@ -21,6 +21,9 @@ type Loader struct {
 	bindings     func() any
 	bindingsHash [16]byte
 	child        component.Component
+	keyMaps      *KeyMaps
+	theme        *component.Theme
+	size         *tea.WindowSizeMsg
 	focus        bool
 	loading      bool
 	spinner      spinner.Model
@@ -33,6 +36,9 @@ func NewLoader(childFn func() component.Component) *Loader {
 		bindings:     func() any { return nil },
 		bindingsHash: [16]byte{},
 		child:        nil,
+		keyMaps:      nil,
+		theme:        nil,
+		size:         nil,
 		focus:        false,
 		loading:      true,
 		spinner:      spinner.New(),
@@ -57,9 +63,17 @@ func (m *Loader) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if typedMsg.id == m.id && typedMsg.hash == m.bindingsHash {
 			m.child = typedMsg.child
 			m.loading = false
+
+			if m.keyMaps != nil {
+				applyKeyMaps(m.child, *m.keyMaps)
+			}
 		}
 	case component.FocusMsg:
 		m.focus = typedMsg.Focus
+	case component.ThemeMsg:
+		m.theme = &typedMsg.Theme
+	case tea.WindowSizeMsg:
+		m.size = &typedMsg
 	}
 
 	if m.focus != focus {
@@ -92,6 +106,22 @@ func (m *Loader) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	} else if m.child != nil {
 		if m.child != child {
 			cmds = append(cmds, m.child.Init())
+
+			if m.size != nil {
+				var cmd tea.Cmd
+
+				m.child, cmd = m.child.Update(*m.size)
+
+				cmds = append(cmds, cmd)
+			}
+
+			if m.theme != nil {
+				var cmd tea.Cmd
+
+				m.child, cmd = m.child.Update(component.ThemeMsg{Theme: *m.theme})
+
+				cmds = append(cmds, cmd)
+			}
 		}
 
 		if m.child != child || m.focus != focus {
@@ -138,6 +168,28 @@ func (m *Loader) SetBindings(bindings func() any) *Loader {
 	return m
 }
 
+func (m *Loader) load() {
+	bindingsHash := hash(m.bindings())
+
+	if m.child != nil && !m.loading && bindingsHash == m.bindingsHash {
+		return
+	}
+
+	m.bindingsHash = bindingsHash
+	m.child = m.childFn()
+	m.loading = false
+
+	if m.keyMaps != nil {
+		applyKeyMaps(m.child, *m.keyMaps)
+	}
+
+	if m.theme != nil {
+		m.child, _ = m.child.Update(component.ThemeMsg{Theme: *m.theme})
+	}
+
+	m.child.Init()
+}
+
 func hash(value interface{}) [16]byte {
 	data, _ := json.Marshal(value)
 
@@ -0,0 +1,360 @@
+package form
+
+import (
+	"fmt"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type MultiSelectStyle struct {
+	Error lipgloss.Style
+}
+
+type MultiSelectState struct {
+	focus         bool
+	selectedIndex int
+	checked       []bool
+}
+
+type MultiSelect struct {
+	items        []SelectItemProps
+	inline       bool
+	min          int
+	max          int
+	validateFunc func([]string) error
+	err          error
+	theme        *component.Theme
+	keyMap       MultiSelectKeyMap
+	style        MultiSelectStyle
+	overrides    map[string]bool
+	state        MultiSelectState
+}
+
+type MultiSelectKeyMap struct {
+	Prev   key.Binding
+	Next   key.Binding
+	Toggle key.Binding
+	All    key.Binding
+}
+
+var (
+	MultiSelectDefaultStyle  = NewMultiSelectStyle(component.DefaultTheme)
+	MultiSelectDefaultKeyMap = MultiSelectKeyMap{
+		Prev: key.NewBinding(
+			key.WithKeys("left", "up"),
+			key.WithHelp("left/up", "Previous selection"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("right", "down"),
+			key.WithHelp("right/down", "Next selection"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "Toggle selection"),
+		),
+		All: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "Select all/none"),
+		),
+	}
+)
+
+func NewMultiSelectStyle(theme component.Theme) MultiSelectStyle {
+	return MultiSelectStyle{
+		Error: lipgloss.NewStyle().Foreground(theme.Error),
+	}
+}
+
+func NewMultiSelect(items []SelectItemProps) *MultiSelect {
+	m := &MultiSelect{
+		items:        items,
+		inline:       false,
+		min:          0,
+		max:          0,
+		validateFunc: func([]string) error { return nil },
+		err:          nil,
+		theme:        nil,
+		keyMap:       MultiSelectDefaultKeyMap,
+		style:        MultiSelectDefaultStyle,
+		overrides:    map[string]bool{},
+		state: MultiSelectState{
+			focus:         false,
+			selectedIndex: 0,
+			checked:       make([]bool, len(items)),
+		},
+	}
+
+	return m
+}
+
+func (m *MultiSelect) Init() tea.Cmd {
+	return m.updateItems()
+}
+
+func (m *MultiSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	cmds := []tea.Cmd{}
+
+	focus := m.state.focus
+	selectedIndex := m.state.selectedIndex
+	checked := false
+
+	switch typedMsg := msg.(type) {
+	case component.FocusMsg:
+		m.state.focus = typedMsg.Focus
+	case component.ThemeMsg:
+		msg = nil
+
+		if m.theme == nil {
+			m.style = component.Restyle(m.style, NewMultiSelectStyle(typedMsg.Theme), m.overrides)
+			cmds = append(cmds, m.updateTheme(typedMsg.Theme))
+		}
+	case tea.KeyMsg:
+		msg = nil
+
+		switch {
+		case len(m.items) == 0:
+		case key.Matches(typedMsg, m.keyMap.Prev):
+			m.state.selectedIndex--
+
+			if m.state.selectedIndex < 0 {
+				m.state.selectedIndex = len(m.items) - 1
+			}
+		case key.Matches(typedMsg, m.keyMap.Next):
+			m.state.selectedIndex++
+
+			if m.state.selectedIndex >= len(m.items) {
+				m.state.selectedIndex = 0
+			}
+		case key.Matches(typedMsg, m.keyMap.Toggle):
+			m.state.checked[m.state.selectedIndex] = !m.state.checked[m.state.selectedIndex]
+			checked = true
+		case key.Matches(typedMsg, m.keyMap.All):
+			if m.count() == len(m.items) {
+				m.SelectNone()
+			} else {
+				m.SelectAll()
+			}
+
+			checked = true
+		}
+	}
+
+	if m.state.focus != focus || m.state.selectedIndex != selectedIndex || checked {
+		cmds = append(cmds, m.updateItems())
+	}
+
+	if len(m.items) > 0 {
+		var cmd tea.Cmd
+
+		m.items[m.state.selectedIndex].Component, cmd = m.items[m.state.selectedIndex].Component.Update(msg)
+
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *MultiSelect) View() string {
+	var s string
+
+	for i, item := range m.items {
+		s += item.Component.View()
+
+		if i < len(m.items)-1 {
+			if m.inline {
+				s += " "
+			} else {
+				s += "\n"
+			}
+		}
+	}
+
+	if m.err != nil {
+		s += "\n" + m.style.Error.Render(m.err.Error())
+	}
+
+	return s
+}
+
+func (m *MultiSelect) Keys() []key.Binding {
+	return []key.Binding{m.keyMap.Prev, m.keyMap.Next, m.keyMap.Toggle, m.keyMap.All}
+}
+
+func (m *MultiSelect) Values() []string {
+	values := []string{}
+
+	for i, item := range m.items {
+		if m.state.checked[i] {
+			values = append(values, item.Value)
+		}
+	}
+
+	return values
+}
+
+func (m *MultiSelect) Validate() bool {
+	count := m.count()
+
+	switch {
+	case m.min > 0 && count < m.min:
+		m.err = fmt.Errorf("select at least %d option(s)", m.min)
+	case m.max > 0 && count > m.max:
+		m.err = fmt.Errorf("select at most %d option(s)", m.max)
+	default:
+		m.err = m.validateFunc(m.Values())
+	}
+
+	return m.err == nil
+}
+
+func (m *MultiSelect) Error() error {
+	return m.err
+}
+
+func (m *MultiSelect) SetError(err error) {
+	m.err = err
+}
+
+func (m *MultiSelect) SelectAll() *MultiSelect {
+	for i := range m.state.checked {
+		m.state.checked[i] = true
+	}
+
+	return m
+}
+
+func (m *MultiSelect) SelectNone() *MultiSelect {
+	for i := range m.state.checked {
+		m.state.checked[i] = false
+	}
+
+	return m
+}
+
+func (m *MultiSelect) SetInline(inline bool) *MultiSelect {
+	m.inline = inline
+
+	return m
+}
+
+func (m *MultiSelect) SetSelectedIndex(index int) *MultiSelect {
+	if index >= len(m.items) {
+		index = len(m.items) - 1
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	m.state.selectedIndex = index
+
+	return m
+}
+
+func (m *MultiSelect) SetChecked(index int, checked bool) *MultiSelect {
+	if index >= 0 && index < len(m.items) {
+		m.state.checked[index] = checked
+	}
+
+	return m
+}
+
+func (m *MultiSelect) SetValues(values []string) *MultiSelect {
+	for i, item := range m.items {
+		m.state.checked[i] = false
+
+		for _, value := range values {
+			if item.Value == value {
+				m.state.checked[i] = true
+
+				break
+			}
+		}
+	}
+
+	return m
+}
+
+func (m *MultiSelect) SetMin(min int) *MultiSelect {
+	m.min = min
+
+	return m
+}
+
+func (m *MultiSelect) SetMax(max int) *MultiSelect {
+	m.max = max
+
+	return m
+}
+
+func (m *MultiSelect) SetValidateFunc(fn func([]string) error) *MultiSelect {
+	m.validateFunc = fn
+
+	return m
+}
+
+func (m *MultiSelect) SetKeyMap(keyMap MultiSelectKeyMap) *MultiSelect {
+	m.keyMap = keyMap
+
+	return m
+}
+
+func (m *MultiSelect) SetTheme(theme component.Theme) *MultiSelect {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewMultiSelectStyle(theme), m.overrides)
+	m.updateTheme(theme)
+
+	return m
+}
+
+func (m *MultiSelect) SetErrorStyle(style lipgloss.Style) *MultiSelect {
+	m.style.Error = style
+	m.overrides["Error"] = true
+
+	return m
+}
+
+func (m *MultiSelect) count() int {
+	count := 0
+
+	for _, checked := range m.state.checked {
+		if checked {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (m *MultiSelect) updateTheme(theme component.Theme) tea.Cmd {
+	cmds := make([]tea.Cmd, len(m.items))
+
+	for i, item := range m.items {
+		m.items[i].Component, cmds[i] = item.Component.Update(component.ThemeMsg{Theme: theme})
+	}
+
+	return tea.Batch(cmds...)
+}
+
+func (m *MultiSelect) updateItems() tea.Cmd {
+	cmds := make([]tea.Cmd, len(m.items)*3)
+
+	for i, item := range m.items {
+		var focusCmd tea.Cmd
+		var activeCmd tea.Cmd
+		var checkCmd tea.Cmd
+
+		item.Component, focusCmd = item.Component.Update(component.FocusMsg{Focus: i == m.state.selectedIndex && m.state.focus})
+		item.Component, activeCmd = item.Component.Update(component.ActiveMsg{Active: i == m.state.selectedIndex})
+		item.Component, checkCmd = item.Component.Update(component.CheckMsg{Checked: m.state.checked[i]})
+
+		cmds[i*3] = focusCmd
+		cmds[i*3+1] = activeCmd
+		cmds[i*3+2] = checkCmd
+	}
+
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,287 @@
+package form
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Number struct {
+	float        bool
+	min          float64
+	max          float64
+	step         float64
+	hasMin       bool
+	hasMax       bool
+	required     bool
+	validateFunc func(float64) error
+	input        *Input
+	keyMap       NumberKeyMap
+}
+
+type NumberKeyMap struct {
+	Increment key.Binding
+	Decrement key.Binding
+}
+
+var (
+	NumberDefaultKeyMap = NumberKeyMap{
+		Increment: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithHelp("up", "Increment"),
+		),
+		Decrement: key.NewBinding(
+			key.WithKeys("down"),
+			key.WithHelp("down", "Decrement"),
+		),
+	}
+)
+
+func NewNumber() *Number {
+	m := &Number{
+		float:        false,
+		min:          0,
+		max:          0,
+		step:         1,
+		hasMin:       false,
+		hasMax:       false,
+		required:     false,
+		validateFunc: func(float64) error { return nil },
+		input:        NewInput(),
+		keyMap:       NumberDefaultKeyMap,
+	}
+
+	m.input.
+		SetAcceptFunc(m.accept).
+		SetValidateFunc(m.validate)
+
+	return m
+}
+
+func (m *Number) Init() tea.Cmd {
+	return m.input.Init()
+}
+
+func (m *Number) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if typedMsg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(typedMsg, m.keyMap.Increment):
+			m.increment(m.step)
+
+			return m, nil
+		case key.Matches(typedMsg, m.keyMap.Decrement):
+			m.increment(-m.step)
+
+			return m, nil
+		}
+	}
+
+	_, cmd := m.input.Update(msg)
+
+	return m, cmd
+}
+
+func (m *Number) View() string {
+	return m.input.View()
+}
+
+func (m *Number) Keys() []key.Binding {
+	return []key.Binding{m.keyMap.Increment, m.keyMap.Decrement}
+}
+
+func (m *Number) Value() string {
+	return m.input.Value()
+}
+
+func (m *Number) TypedValue() (any, error) {
+	if m.float {
+		return parseFloat(m.input.Value())
+	}
+
+	return parseInt(m.input.Value())
+}
+
+func (m *Number) Number() float64 {
+	v, _ := m.parse(m.input.Value())
+
+	return v
+}
+
+func (m *Number) Validate() bool {
+	return m.input.Validate()
+}
+
+func (m *Number) Error() error {
+	return m.input.Error()
+}
+
+func (m *Number) SetError(err error) {
+	m.input.SetError(err)
+}
+
+func (m *Number) SetFloat(float bool) *Number {
+	m.float = float
+
+	return m
+}
+
+func (m *Number) SetMin(min float64) *Number {
+	m.min = min
+	m.hasMin = true
+
+	return m
+}
+
+func (m *Number) SetMax(max float64) *Number {
+	m.max = max
+	m.hasMax = true
+
+	return m
+}
+
+func (m *Number) SetStep(step float64) *Number {
+	m.step = step
+
+	return m
+}
+
+func (m *Number) SetRequired(required bool) *Number {
+	m.required = required
+
+	return m
+}
+
+func (m *Number) SetValue(value float64) *Number {
+	m.input.SetValue(m.format(value))
+
+	return m
+}
+
+func (m *Number) SetPlaceholder(placeholder string) *Number {
+	m.input.SetPlaceholder(placeholder)
+
+	return m
+}
+
+func (m *Number) SetValidateFunc(fn func(float64) error) *Number {
+	m.validateFunc = fn
+
+	return m
+}
+
+func (m *Number) SetKeyMap(keyMap NumberKeyMap) *Number {
+	m.keyMap = keyMap
+
+	return m
+}
+
+func (m *Number) SetTheme(theme component.Theme) *Number {
+	m.input.SetTheme(theme)
+
+	return m
+}
+
+func (m *Number) SetErrorStyle(style lipgloss.Style) *Number {
+	m.input.SetErrorStyle(style)
+
+	return m
+}
+
+func (m *Number) Input() *Input {
+	return m.input
+}
+
+func (m *Number) increment(delta float64) {
+	v, err := m.parse(m.input.Value())
+	if err != nil {
+		return
+	}
+
+	scale := math.Pow10(max(decimals(m.step), decimals(v)))
+
+	if strings.TrimSpace(m.input.Value()) == "" && m.hasMin {
+		v = m.min
+	} else {
+		v = math.Round((v+delta)*scale) / scale
+	}
+
+	if m.hasMin {
+		v = math.Max(v, m.min)
+	}
+
+	if m.hasMax {
+		v = math.Min(v, m.max)
+	}
+
+	m.SetValue(v)
+}
+
+func (m *Number) parse(s string) (float64, error) {
+	if m.float {
+		return parseFloat(s)
+	}
+
+	v, err := parseInt(s)
+
+	return float64(v), err
+}
+
+func (m *Number) format(v float64) string {
+	if !m.float {
+		return strconv.FormatInt(int64(math.Round(v)), 10)
+	}
+
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func (m *Number) accept(r rune) bool {
+	switch {
+	case AcceptDigits(r):
+		return true
+	case r == '-':
+		return !m.hasMin || m.min < 0
+	case r == '.':
+		return m.float
+	}
+
+	return false
+}
+
+func (m *Number) validate(s string) error {
+	if strings.TrimSpace(s) == "" {
+		if m.required {
+			return fmt.Errorf("value is required")
+		}
+
+		return nil
+	}
+
+	v, err := m.parse(s)
+	if err != nil {
+		return err
+	}
+
+	if m.hasMin && v < m.min {
+		return fmt.Errorf("must be at least %s", m.format(m.min))
+	}
+
+	if m.hasMax && v > m.max {
+		return fmt.Errorf("must be at most %s", m.format(m.max))
+	}
+
+	return m.validateFunc(v)
+}
+
+func decimals(v float64) int {
+	if _, fraction, ok := strings.Cut(strconv.FormatFloat(v, 'f', -1, 64), "."); ok {
+		return len(fraction)
+	}
+
+	return 0
+}
@@ -1,13 +1,22 @@
 package form
 
 import (
+	"sync/atomic"
+
 	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
+type NextMsg struct{}
+
 type WithKeys interface {
 	Keys() []key.Binding
 }
 
+type WithCapture interface {
+	Captures() []key.Binding
+}
+
 type WithSkip interface {
 	Skip() bool
 }
@@ -16,11 +25,44 @@ type WithHide interface {
 	Hide() bool
 }
 
+type WithNext interface {
+	Next() string
+}
+
 type WithValidation interface {
 	Validate() bool
 	Error() error
 }
 
+type WithError interface {
+	SetError(err error)
+}
+
+type WithAsyncValidation interface {
+	ValidateCmd() tea.Cmd
+	Validating() bool
+}
+
 type WithValue interface {
 	Value() string
 }
+
+type WithValues interface {
+	Values() []string
+}
+
+type WithSensitive interface {
+	Sensitive() bool
+}
+
+type WithTypedValue interface {
+	TypedValue() (any, error)
+}
+
+var (
+	lastID int64
+)
+
+func nextID() int {
+	return int(atomic.AddInt64(&lastID, 1))
+}
@@ -0,0 +1,248 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var ErrAborted = errors.New("form aborted")
+
+type RunKeyMap struct {
+	Abort key.Binding
+}
+
+type RunOption func(*runOptions)
+
+type runOptions struct {
+	altScreen bool
+	summary   bool
+	title     string
+	input     io.Reader
+	output    io.Writer
+	keyMap    RunKeyMap
+}
+
+type runModel struct {
+	form    *Form
+	help    help.Model
+	title   string
+	keyMap  RunKeyMap
+	aborted bool
+}
+
+var RunDefaultKeyMap = RunKeyMap{
+	Abort: key.NewBinding(
+		key.WithKeys("ctrl+c"),
+		key.WithHelp("ctrl+c", "Exit"),
+	),
+}
+
+func WithAltScreen(altScreen bool) RunOption {
+	return func(o *runOptions) {
+		o.altScreen = altScreen
+	}
+}
+
+func WithSummary(summary bool) RunOption {
+	return func(o *runOptions) {
+		o.summary = summary
+	}
+}
+
+func WithTitle(title string) RunOption {
+	return func(o *runOptions) {
+		o.title = title
+	}
+}
+
+func WithInput(input io.Reader) RunOption {
+	return func(o *runOptions) {
+		o.input = input
+	}
+}
+
+func WithOutput(output io.Writer) RunOption {
+	return func(o *runOptions) {
+		o.output = output
+	}
+}
+
+func WithRunKeyMap(keyMap RunKeyMap) RunOption {
+	return func(o *runOptions) {
+		o.keyMap = keyMap
+	}
+}
+
+func Run(ctx context.Context, f *Form, opts ...RunOption) (map[string]any, error) {
+	o := runOptions{
+		altScreen: false,
+		summary:   true,
+		title:     "",
+		input:     os.Stdin,
+		output:    os.Stdout,
+		keyMap:    RunDefaultKeyMap,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if ctx.Err() != nil {
+		f.Cancel()
+
+		return nil, ErrAborted
+	}
+
+	var err error
+
+	switch {
+	case AccessibleMode():
+		err = f.runAccessible(ctx, o.input, o.output)
+	case !isTerminal(o.input) || !isTerminal(o.output):
+		err = f.runNonInteractive(ctx)
+	default:
+		err = runProgram(ctx, f, o)
+	}
+
+	if ctx.Err() != nil {
+		f.Cancel()
+
+		return nil, ErrAborted
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.Completed() {
+		return nil, ErrAborted
+	}
+
+	if o.summary {
+		fmt.Fprintln(o.output, f.Summary())
+	}
+
+	return f.Values(), nil
+}
+
+func (m *Form) Summary() string {
+	values := m.RedactedValues()
+	lines := []string{}
+
+	for _, index := range m.walk() {
+		item := m.items[index]
+
+		value, ok := values[item.Name]
+		if !ok {
+			continue
+		}
+
+		lines = append(lines, m.style.Description.Render(item.Name+":")+" "+summaryValue(item.Component, value))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func runProgram(ctx context.Context, f *Form, o runOptions) error {
+	options := []tea.ProgramOption{
+		tea.WithContext(ctx),
+		tea.WithInput(o.input),
+		tea.WithOutput(o.output),
+	}
+
+	if o.altScreen {
+		options = append(options, tea.WithAltScreen())
+	}
+
+	model, err := tea.NewProgram(&runModel{
+		form:    f,
+		help:    help.New(),
+		title:   o.title,
+		keyMap:  o.keyMap,
+		aborted: false,
+	}, options...).Run()
+
+	if errors.Is(err, tea.ErrProgramKilled) {
+		f.Cancel()
+
+		return ErrAborted
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if model.(*runModel).aborted {
+		return ErrAborted
+	}
+
+	return nil
+}
+
+func (m *runModel) Init() tea.Cmd {
+	return m.form.Init()
+}
+
+func (m *runModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch typedMsg := msg.(type) {
+	case FormSubmittedMsg, FormCancelledMsg:
+		return m, tea.Quit
+	case tea.WindowSizeMsg:
+		m.help.Width = typedMsg.Width
+	case tea.KeyMsg:
+		if key.Matches(typedMsg, m.keyMap.Abort) {
+			m.aborted = true
+			m.form.Cancel()
+
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.form, cmd = m.form.Update(msg)
+
+	return m, cmd
+}
+
+func (m *runModel) View() string {
+	if m.form.Completed() || m.form.Cancelled() {
+		return ""
+	}
+
+	s := ""
+
+	if m.title != "" {
+		s += m.form.style.Title.Render(m.title) + "\n\n"
+	}
+
+	s += m.form.View()
+	s += "\n\n" + m.help.ShortHelpView(append(m.form.Keys(), m.keyMap.Abort))
+
+	return s + "\n"
+}
+
+func summaryValue(m component.Component, value any) string {
+	if value == RedactedValue {
+		return RedactedValue
+	}
+
+	if withValues, ok := withValues(m); ok {
+		return strings.Join(withValues.Values(), ", ")
+	}
+
+	if withValue, ok := withValue(m); ok {
+		return withValue.Value()
+	}
+
+	return fmt.Sprint(value)
+}
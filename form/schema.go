@@ -0,0 +1,545 @@
+package form
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+type SchemaOption struct {
+	Value string `yaml:"value"`
+	Label string `yaml:"label"`
+}
+
+type SchemaCondition struct {
+	Item      string   `yaml:"item"`
+	Equals    *string  `yaml:"equals"`
+	NotEquals *string  `yaml:"notEquals"`
+	In        []string `yaml:"in"`
+	Empty     *bool    `yaml:"empty"`
+	always    *bool
+	line      int
+	column    int
+}
+
+type SchemaItem struct {
+	Name        string           `yaml:"name"`
+	Label       string           `yaml:"label"`
+	Kind        string           `yaml:"kind"`
+	Text        string           `yaml:"text"`
+	Placeholder string           `yaml:"placeholder"`
+	Secret      bool             `yaml:"secret"`
+	Mask        string           `yaml:"mask"`
+	Suggestions []string         `yaml:"suggestions"`
+	Default     string           `yaml:"default"`
+	Validate    string           `yaml:"validate"`
+	Options     []SchemaOption   `yaml:"options"`
+	Loader      string           `yaml:"loader"`
+	Bindings    []string         `yaml:"bindings"`
+	Inline      bool             `yaml:"inline"`
+	Affirmative string           `yaml:"affirmative"`
+	Negative    string           `yaml:"negative"`
+	Min         *float64         `yaml:"min"`
+	Max         *float64         `yaml:"max"`
+	Float       bool             `yaml:"float"`
+	MinSelected int              `yaml:"minSelected"`
+	MaxSelected int              `yaml:"maxSelected"`
+	Layout      string           `yaml:"layout"`
+	Time        bool             `yaml:"time"`
+	Hide        *SchemaCondition `yaml:"hide"`
+	Skip        *SchemaCondition `yaml:"skip"`
+	line        int
+	column      int
+}
+
+type Schema struct {
+	Items    []SchemaItem `yaml:"items"`
+	file     string
+	loaders  map[string]func() []SchemaOption
+	validate *validator.Validate
+}
+
+type SchemaError struct {
+	File   string
+	Line   int
+	Column int
+	Err    error
+}
+
+var (
+	schemaLineRegexp = regexp.MustCompile(`line (\d+): (.*)`)
+)
+
+const (
+	SchemaKindInput       = "input"
+	SchemaKindSelect      = "select"
+	SchemaKindMultiSelect = "multiselect"
+	SchemaKindConfirm     = "confirm"
+	SchemaKindNumber      = "number"
+	SchemaKindDate        = "date"
+	SchemaKindText        = "text"
+)
+
+func (e *SchemaError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.File, e.Err)
+	}
+
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Err)
+}
+
+func (e *SchemaError) Unwrap() error {
+	return e.Err
+}
+
+func LoadSchema(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSchema(path, data)
+}
+
+func ParseSchema(file string, data []byte) (*Schema, error) {
+	s := &Schema{
+		Items:    []SchemaItem{},
+		file:     file,
+		loaders:  map[string]func() []SchemaOption{},
+		validate: validator.New(),
+	}
+
+	var root yaml.Node
+
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, schemaYAMLError(file, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(s); err != nil && err != io.EOF {
+		return nil, schemaYAMLError(file, err)
+	}
+
+	if items := schemaNode(&root, "items"); items != nil && items.Kind == yaml.SequenceNode {
+		for i, item := range items.Content {
+			if i < len(s.Items) {
+				s.Items[i].line = item.Line
+				s.Items[i].column = item.Column
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Schema) SetLoader(name string, fn func() []SchemaOption) *Schema {
+	s.loaders[name] = fn
+
+	return s
+}
+
+func (s *Schema) FormItems() ([]FormItem, error) {
+	items := make([]FormItem, 0, len(s.Items))
+	names := map[string]bool{}
+
+	for _, item := range s.Items {
+		if item.Name == "" {
+			return nil, s.errorf(item.line, item.column, "item name is required")
+		}
+
+		if names[item.Name] {
+			return nil, s.errorf(item.line, item.column, "duplicate item name %q", item.Name)
+		}
+
+		names[item.Name] = true
+	}
+
+	for _, item := range s.Items {
+		for _, condition := range []*SchemaCondition{item.Hide, item.Skip} {
+			if condition != nil && condition.always == nil && !names[condition.Item] {
+				return nil, s.errorf(condition.line, condition.column, "condition references unknown item %q", condition.Item)
+			}
+		}
+
+		for _, binding := range item.Bindings {
+			if !names[binding] {
+				return nil, s.errorf(item.line, item.column, "binding references unknown item %q", binding)
+			}
+		}
+	}
+
+	for _, item := range s.Items {
+		child, err := s.component(item, &items)
+		if err != nil {
+			return nil, err
+		}
+
+		if item.Label != "" {
+			child = NewField(item.Label, child)
+		}
+
+		if item.Skip != nil {
+			child = NewSkip(child).SetSkip(s.condition(item.Skip, &items))
+		}
+
+		if item.Hide != nil {
+			child = NewHide(child).SetHide(s.condition(item.Hide, &items))
+		}
+
+		items = append(items, FormItem{
+			Name:      item.Name,
+			Component: child,
+		})
+	}
+
+	return items, nil
+}
+
+func (s *Schema) component(item SchemaItem, items *[]FormItem) (component.Component, error) {
+	name := item.Name
+	rules := item.Validate
+
+	switch item.Kind {
+	case SchemaKindInput, "":
+		input := NewInput().
+			SetPlaceholder(item.Placeholder).
+			SetValue(item.Default).
+			SetSecret(item.Secret).
+			SetFormatMask(item.Mask).
+			SetSuggestions(item.Suggestions).
+			SetValidateFunc(func(value string) error {
+				return validateField(s.validate, name, value, rules)
+			})
+
+		return input, nil
+	case SchemaKindSelect:
+		if item.Loader != "" {
+			loader, ok := s.loaders[item.Loader]
+
+			if !ok {
+				return nil, s.errorf(item.line, item.column, "unknown loader %q", item.Loader)
+			}
+
+			bindings := item.Bindings
+
+			return NewLoader(func() component.Component {
+				return s.selectComponent(item, loader())
+			}).
+				SetBindings(func() any {
+					values := make([]any, len(bindings))
+
+					for i, binding := range bindings {
+						values[i] = schemaValue(*items, binding)
+					}
+
+					return values
+				}), nil
+		}
+
+		if len(item.Options) == 0 {
+			return nil, s.errorf(item.line, item.column, "select item %q requires options or a loader", name)
+		}
+
+		return s.selectComponent(item, item.Options), nil
+	case SchemaKindMultiSelect:
+		if len(item.Options) == 0 {
+			return nil, s.errorf(item.line, item.column, "multiselect item %q requires options", name)
+		}
+
+		multiSelect := NewMultiSelect(schemaProps(item.Options)).
+			SetInline(item.Inline).
+			SetMin(item.MinSelected).
+			SetMax(item.MaxSelected).
+			SetValidateFunc(func(values []string) error {
+				return validateField(s.validate, name, values, rules)
+			})
+
+		if item.Default != "" {
+			multiSelect.SetValues(parseStrings(item.Default, ","))
+		}
+
+		return multiSelect, nil
+	case SchemaKindConfirm:
+		value, err := parseBool(item.Default)
+		if err != nil {
+			return nil, s.errorf(item.line, item.column, "confirm item %q default: %s", name, err)
+		}
+
+		confirm := NewConfirm().
+			SetValue(value)
+
+		if item.Affirmative != "" {
+			confirm.SetAffirmative(item.Affirmative)
+		}
+
+		if item.Negative != "" {
+			confirm.SetNegative(item.Negative)
+		}
+
+		return confirm, nil
+	case SchemaKindNumber:
+		number := NewNumber().
+			SetFloat(item.Float).
+			SetPlaceholder(item.Placeholder).
+			SetValidateFunc(func(value float64) error {
+				return validateField(s.validate, name, value, rules)
+			})
+
+		if item.Min != nil {
+			number.SetMin(*item.Min)
+		}
+
+		if item.Max != nil {
+			number.SetMax(*item.Max)
+		}
+
+		if item.Default != "" {
+			value, err := parseFloat(item.Default)
+			if err != nil {
+				return nil, s.errorf(item.line, item.column, "number item %q default: %s", name, err)
+			}
+
+			if value != math.Trunc(value) {
+				number.SetFloat(true)
+			}
+
+			number.SetValue(value)
+		}
+
+		return number, nil
+	case SchemaKindDate:
+		date := NewDatePicker().
+			SetLayout(item.Layout).
+			SetTime(item.Time).
+			SetValidateFunc(func(value time.Time) error {
+				return validateField(s.validate, name, value, rules)
+			})
+
+		if item.Default != "" {
+			value, err := time.ParseInLocation(date.Layout(), item.Default, time.Local)
+			if err != nil {
+				return nil, s.errorf(item.line, item.column, "date item %q default: %s", name, err)
+			}
+
+			date.SetValue(value)
+		}
+
+		return date, nil
+	case SchemaKindText:
+		return component.NewText(item.Text), nil
+	}
+
+	return nil, s.errorf(item.line, item.column, "unknown kind %q", item.Kind)
+}
+
+func (s *Schema) selectComponent(item SchemaItem, options []SchemaOption) component.Component {
+	selected := NewSelect(schemaProps(options)).
+		SetInline(item.Inline)
+
+	for i, option := range options {
+		if option.Value == item.Default {
+			selected.SetSelectedIndex(i)
+		}
+	}
+
+	return selected
+}
+
+func (s *Schema) condition(condition *SchemaCondition, items *[]FormItem) func() bool {
+	return func() bool {
+		if condition.always != nil {
+			return *condition.always
+		}
+
+		values := schemaStrings(schemaValue(*items, condition.Item))
+
+		if condition.Equals != nil && !slices.Contains(values, *condition.Equals) {
+			return false
+		}
+
+		if condition.NotEquals != nil && slices.Contains(values, *condition.NotEquals) {
+			return false
+		}
+
+		if condition.In != nil && !slices.ContainsFunc(values, func(value string) bool {
+			return slices.Contains(condition.In, value)
+		}) {
+			return false
+		}
+
+		if condition.Empty != nil && (len(values) == 0) != *condition.Empty {
+			return false
+		}
+
+		return true
+	}
+}
+
+func (s *Schema) errorf(line int, column int, format string, args ...any) error {
+	return &SchemaError{
+		File:   s.file,
+		Line:   line,
+		Column: column,
+		Err:    fmt.Errorf(format, args...),
+	}
+}
+
+func (o *SchemaOption) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		o.Value = node.Value
+		o.Label = node.Value
+
+		return nil
+	}
+
+	if err := schemaKnownFields(node, "value", "label"); err != nil {
+		return err
+	}
+
+	type plain SchemaOption
+
+	if err := node.Decode((*plain)(o)); err != nil {
+		return err
+	}
+
+	if o.Label == "" {
+		o.Label = o.Value
+	}
+
+	return nil
+}
+
+func (c *SchemaCondition) UnmarshalYAML(node *yaml.Node) error {
+	c.line = node.Line
+	c.column = node.Column
+
+	if node.Kind == yaml.ScalarNode {
+		var always bool
+
+		if err := node.Decode(&always); err != nil {
+			return err
+		}
+
+		c.always = &always
+
+		return nil
+	}
+
+	if err := schemaKnownFields(node, "item", "equals", "notEquals", "in", "empty"); err != nil {
+		return err
+	}
+
+	type plain SchemaCondition
+
+	if err := node.Decode((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.Item == "" {
+		return fmt.Errorf("line %d: condition item is required", node.Line)
+	}
+
+	return nil
+}
+
+func schemaYAMLError(file string, err error) error {
+	message := err.Error()
+
+	if typeError, ok := err.(*yaml.TypeError); ok && len(typeError.Errors) > 0 {
+		message = typeError.Errors[0]
+	}
+
+	if match := schemaLineRegexp.FindStringSubmatch(message); match != nil {
+		line, _ := strconv.Atoi(match[1])
+
+		return &SchemaError{File: file, Line: line, Column: 1, Err: errors.New(match[2])}
+	}
+
+	return &SchemaError{File: file, Err: err}
+}
+
+func schemaNode(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func schemaKnownFields(node *yaml.Node, keys ...string) error {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i < len(node.Content); i += 2 {
+		if !slices.Contains(keys, node.Content[i].Value) {
+			return fmt.Errorf("line %d: field %s not found", node.Content[i].Line, node.Content[i].Value)
+		}
+	}
+
+	return nil
+}
+
+func schemaProps(options []SchemaOption) []SelectItemProps {
+	props := make([]SelectItemProps, len(options))
+
+	for i, option := range options {
+		props[i] = SelectItemProps{
+			Value:     option.Value,
+			Component: component.NewOption(option.Label),
+		}
+	}
+
+	return props
+}
+
+func schemaValue(items []FormItem, name string) any {
+	for _, item := range items {
+		if item.Name == name {
+			return value(item.Component)
+		}
+	}
+
+	return nil
+}
+
+func schemaStrings(value any) []string {
+	switch typed := value.(type) {
+	case nil:
+		return []string{}
+	case []string:
+		return typed
+	case string:
+		if typed == "" {
+			return []string{}
+		}
+
+		return []string{typed}
+	}
+
+	return []string{strings.TrimSpace(fmt.Sprint(value))}
+}
@@ -0,0 +1,65 @@
+package form
+
+import "testing"
+
+func TestSchemaNumberZeroBounds(t *testing.T) {
+	schema, err := ParseSchema("form.yaml", []byte(`items:
+  - name: count
+    kind: number
+    min: 0
+    max: 10
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := schema.FormItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, c := leaf(items[0])
+
+	number, ok := c.(*Number)
+	if !ok {
+		t.Fatalf("expected *Number, got %T", c)
+	}
+
+	for _, tc := range []struct {
+		value string
+		valid bool
+	}{
+		{"-1", false},
+		{"0", true},
+		{"10", true},
+		{"11", false},
+	} {
+		number.Input().SetValue(tc.value)
+
+		if valid := number.Validate(); valid != tc.valid {
+			t.Errorf("Validate() with %s = %v, want %v", tc.value, valid, tc.valid)
+		}
+	}
+}
+
+func TestSchemaNumberFloatDefault(t *testing.T) {
+	schema, err := ParseSchema("form.yaml", []byte(`items:
+  - name: ratio
+    kind: number
+    default: "0.5"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := schema.FormItems()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, c := leaf(items[0])
+
+	if value := c.(*Number).Value(); value != "0.5" {
+		t.Errorf("Value() = %q, want %q", value, "0.5")
+	}
+}
@@ -1,9 +1,15 @@
 package form
 
 import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
 	"github.com/MrSquaare/boba/component"
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
 )
 
 type SelectItemProps struct {
@@ -11,24 +17,54 @@ type SelectItemProps struct {
 	Value     string
 }
 
+type SelectStyle struct {
+	FilterPrompt      lipgloss.Style
+	FilterText        lipgloss.Style
+	FilterPlaceholder lipgloss.Style
+	Empty             lipgloss.Style
+	More              lipgloss.Style
+}
+
 type SelectState struct {
 	focus         bool
 	selectedIndex int
+	filter        string
+	visible       []int
+	matches       map[int][]int
+	offset        int
+	windowWidth   int
+	windowHeight  int
 }
 
 type Select struct {
-	items  []SelectItemProps
-	inline bool
-	state  SelectState
+	items             []SelectItemProps
+	inline            bool
+	filterable        bool
+	filterPrompt      string
+	filterPlaceholder string
+	emptyText         string
+	height            int
+	width             int
+	theme             *component.Theme
+	keyMap            SelectKeyMap
+	style             SelectStyle
+	overrides         map[string]bool
+	state             SelectState
 }
 
 type SelectKeyMap struct {
-	Prev key.Binding
-	Next key.Binding
+	Prev        key.Binding
+	Next        key.Binding
+	PageUp      key.Binding
+	PageDown    key.Binding
+	Home        key.Binding
+	End         key.Binding
+	ClearFilter key.Binding
 }
 
 var (
-	selectKeyMap = SelectKeyMap{
+	SelectDefaultStyle  = NewSelectStyle(component.DefaultTheme)
+	SelectDefaultKeyMap = SelectKeyMap{
 		Prev: key.NewBinding(
 			key.WithKeys("left", "up"),
 			key.WithHelp("left/up", "Previous selection"),
@@ -37,19 +73,67 @@ var (
 			key.WithKeys("right", "down"),
 			key.WithHelp("right/down", "Next selection"),
 		),
+		PageUp: key.NewBinding(
+			key.WithKeys("pgup"),
+			key.WithHelp("pgup", "Previous page"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("pgdown"),
+			key.WithHelp("pgdown", "Next page"),
+		),
+		Home: key.NewBinding(
+			key.WithKeys("home"),
+			key.WithHelp("home", "First selection"),
+		),
+		End: key.NewBinding(
+			key.WithKeys("end"),
+			key.WithHelp("end", "Last selection"),
+		),
+		ClearFilter: key.NewBinding(
+			key.WithKeys("ctrl+u"),
+			key.WithHelp("ctrl+u", "Clear filter"),
+		),
 	}
 )
 
+func NewSelectStyle(theme component.Theme) SelectStyle {
+	return SelectStyle{
+		FilterPrompt:      lipgloss.NewStyle().Foreground(theme.Accent),
+		FilterText:        lipgloss.NewStyle().Foreground(theme.Foreground),
+		FilterPlaceholder: lipgloss.NewStyle().Foreground(theme.Muted),
+		Empty:             lipgloss.NewStyle().Foreground(theme.Muted),
+		More:              lipgloss.NewStyle().Foreground(theme.Muted),
+	}
+}
+
 func NewSelect(items []SelectItemProps) *Select {
 	m := &Select{
-		items:  items,
-		inline: false,
+		items:             items,
+		inline:            false,
+		filterable:        false,
+		filterPrompt:      "/ ",
+		filterPlaceholder: "Type to filter",
+		emptyText:         "No matches",
+		height:            0,
+		width:             0,
+		theme:             nil,
+		keyMap:            SelectDefaultKeyMap,
+		style:             SelectDefaultStyle,
+		overrides:         map[string]bool{},
 		state: SelectState{
 			focus:         false,
 			selectedIndex: 0,
+			filter:        "",
+			visible:       nil,
+			matches:       map[int][]int{},
+			offset:        0,
+			windowWidth:   0,
+			windowHeight:  0,
 		},
 	}
 
+	m.filterItems()
+
 	return m
 }
 
@@ -62,32 +146,47 @@ func (m *Select) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	focus := m.state.focus
 	selectedIndex := m.state.selectedIndex
+	filter := m.state.filter
 
 	switch typedMsg := msg.(type) {
 	case component.FocusMsg:
 		m.state.focus = typedMsg.Focus
+	case component.ThemeMsg:
+		msg = nil
+
+		if m.theme == nil {
+			m.style = component.Restyle(m.style, NewSelectStyle(typedMsg.Theme), m.overrides)
+			cmds = append(cmds, m.updateTheme(typedMsg.Theme))
+		}
+	case tea.WindowSizeMsg:
+		m.state.windowWidth = typedMsg.Width
+		m.state.windowHeight = typedMsg.Height
 	case tea.KeyMsg:
 		msg = nil
 
 		switch {
-		case key.Matches(typedMsg, selectKeyMap.Prev):
-			m.state.selectedIndex--
-
-			if m.state.selectedIndex < 0 {
-				m.state.selectedIndex = len(m.items) - 1
-			}
-		case key.Matches(typedMsg, selectKeyMap.Next):
-			msg = nil
-
-			m.state.selectedIndex++
-
-			if m.state.selectedIndex >= len(m.items) {
-				m.state.selectedIndex = 0
-			}
+		case key.Matches(typedMsg, m.keyMap.Prev):
+			m.move(-1)
+		case key.Matches(typedMsg, m.keyMap.Next):
+			m.move(1)
+		case key.Matches(typedMsg, m.keyMap.PageUp):
+			m.jump(-m.pageSize())
+		case key.Matches(typedMsg, m.keyMap.PageDown):
+			m.jump(m.pageSize())
+		case key.Matches(typedMsg, m.keyMap.Home):
+			m.jump(-len(m.items))
+		case key.Matches(typedMsg, m.keyMap.End):
+			m.jump(len(m.items))
+		case m.filterable:
+			m.updateFilter(typedMsg)
 		}
 	}
 
-	if m.state.focus != focus || m.state.selectedIndex != selectedIndex {
+	if m.state.filter != filter {
+		m.filterItems()
+	}
+
+	if m.state.focus != focus || m.state.selectedIndex != selectedIndex || m.state.filter != filter {
 		cmds = append(cmds, m.updateItems())
 	}
 
@@ -97,35 +196,97 @@ func (m *Select) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	cmds = append(cmds, cmd)
 
+	m.scroll()
+
 	return m, tea.Batch(cmds...)
 }
 
 func (m *Select) View() string {
 	var s string
 
-	for i, item := range m.items {
-		s += item.Component.View()
-
-		if i < len(m.items)-1 {
-			if m.inline {
-				s += " "
-			} else {
-				s += "\n"
-			}
+	if m.filterable {
+		s += m.style.FilterPrompt.Render(m.filterPrompt)
+
+		if m.state.filter != "" {
+			s += m.style.FilterText.Render(m.state.filter)
+		} else {
+			s += m.style.FilterPlaceholder.Render(m.filterPlaceholder)
+		}
+
+		s += "\n"
+
+		if len(m.state.visible) == 0 {
+			s += m.style.Empty.Render(m.emptyText)
+		}
+	}
+
+	if m.inline {
+		return s + m.inlineView()
+	}
+
+	start, end := m.window()
+
+	if start > 0 {
+		s += m.style.More.Render(fmt.Sprintf("↑ %d more", start)) + "\n"
+	}
+
+	for i, index := range m.state.visible[start:end] {
+		s += m.items[index].Component.View()
+
+		if i < end-start-1 {
+			s += "\n"
 		}
 	}
 
+	if end < len(m.state.visible) {
+		s += "\n" + m.style.More.Render(fmt.Sprintf("↓ %d more", len(m.state.visible)-end))
+	}
+
 	return s
 }
 
 func (m *Select) Keys() []key.Binding {
-	return []key.Binding{selectKeyMap.Prev, selectKeyMap.Next}
+	keys := []key.Binding{m.keyMap.Prev, m.keyMap.Next}
+
+	if start, end := m.window(); start > 0 || end < len(m.state.visible) {
+		keys = append(keys, m.keyMap.PageUp, m.keyMap.PageDown, m.keyMap.Home, m.keyMap.End)
+	}
+
+	if m.filterable {
+		keys = append(keys, m.keyMap.ClearFilter)
+	}
+
+	return keys
+}
+
+func (m *Select) Filter() string {
+	return m.state.filter
+}
+
+func (m *Select) Filterable() bool {
+	return m.filterable
 }
 
 func (m *Select) Value() string {
+	if len(m.state.visible) == 0 {
+		return ""
+	}
+
 	return m.items[m.state.selectedIndex].Value
 }
 
+func (m *Select) Validate() bool {
+	return len(m.state.visible) > 0
+}
+
+func (m *Select) Error() error {
+	if len(m.state.visible) == 0 {
+		return errors.New(m.emptyText)
+	}
+
+	return nil
+}
+
 func (m *Select) SetInline(inline bool) *Select {
 	m.inline = inline
 
@@ -142,23 +303,344 @@ func (m *Select) SetSelectedIndex(index int) *Select {
 	}
 
 	m.state.selectedIndex = index
+	m.scroll()
 
 	return m
 }
 
+func (m *Select) SetFilterable(filterable bool) *Select {
+	m.filterable = filterable
+
+	return m
+}
+
+func (m *Select) SetFilter(filter string) *Select {
+	m.state.filter = filter
+	m.filterItems()
+	m.scroll()
+
+	return m
+}
+
+func (m *Select) SetFilterPrompt(prompt string) *Select {
+	m.filterPrompt = prompt
+
+	return m
+}
+
+func (m *Select) SetFilterPlaceholder(placeholder string) *Select {
+	m.filterPlaceholder = placeholder
+
+	return m
+}
+
+func (m *Select) SetEmptyText(text string) *Select {
+	m.emptyText = text
+
+	return m
+}
+
+func (m *Select) SetHeight(height int) *Select {
+	m.height = height
+
+	return m
+}
+
+func (m *Select) SetWidth(width int) *Select {
+	m.width = width
+
+	return m
+}
+
+func (m *Select) SetKeyMap(keyMap SelectKeyMap) *Select {
+	m.keyMap = keyMap
+
+	return m
+}
+
+func (m *Select) SetTheme(theme component.Theme) *Select {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewSelectStyle(theme), m.overrides)
+	m.updateTheme(theme)
+
+	return m
+}
+
+func (m *Select) SetFilterPromptStyle(style lipgloss.Style) *Select {
+	m.style.FilterPrompt = style
+	m.overrides["FilterPrompt"] = true
+
+	return m
+}
+
+func (m *Select) SetFilterTextStyle(style lipgloss.Style) *Select {
+	m.style.FilterText = style
+	m.overrides["FilterText"] = true
+
+	return m
+}
+
+func (m *Select) SetFilterPlaceholderStyle(style lipgloss.Style) *Select {
+	m.style.FilterPlaceholder = style
+	m.overrides["FilterPlaceholder"] = true
+
+	return m
+}
+
+func (m *Select) SetEmptyStyle(style lipgloss.Style) *Select {
+	m.style.Empty = style
+	m.overrides["Empty"] = true
+
+	return m
+}
+
+func (m *Select) SetMoreStyle(style lipgloss.Style) *Select {
+	m.style.More = style
+	m.overrides["More"] = true
+
+	return m
+}
+
+func (m *Select) move(delta int) {
+	if len(m.state.visible) == 0 {
+		return
+	}
+
+	position := (m.position() + delta + len(m.state.visible)) % len(m.state.visible)
+
+	m.state.selectedIndex = m.state.visible[position]
+}
+
+func (m *Select) jump(delta int) {
+	if len(m.state.visible) == 0 {
+		return
+	}
+
+	position := min(max(m.position()+delta, 0), len(m.state.visible)-1)
+
+	m.state.selectedIndex = m.state.visible[position]
+}
+
+func (m *Select) position() int {
+	for i, index := range m.state.visible {
+		if index == m.state.selectedIndex {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func (m *Select) pageSize() int {
+	if m.height > 0 {
+		return m.height
+	}
+
+	if m.state.windowHeight > 0 {
+		return max(m.state.windowHeight/2, 3)
+	}
+
+	return len(m.state.visible)
+}
+
+func (m *Select) window() (int, int) {
+	if m.inline {
+		return 0, len(m.state.visible)
+	}
+
+	start := min(m.state.offset, len(m.state.visible))
+	end := min(start+m.pageSize(), len(m.state.visible))
+
+	return start, end
+}
+
+func (m *Select) scroll() {
+	position := m.position()
+
+	if m.inline {
+		m.scrollInline(position)
+
+		return
+	}
+
+	height := max(m.pageSize(), 1)
+
+	if position < m.state.offset {
+		m.state.offset = position
+	}
+
+	if position >= m.state.offset+height {
+		m.state.offset = position - height + 1
+	}
+
+	m.state.offset = min(m.state.offset, max(len(m.state.visible)-height, 0))
+}
+
+func (m *Select) scrollInline(position int) {
+	width := m.inlineWidth()
+
+	if width <= 0 {
+		m.state.offset = 0
+
+		return
+	}
+
+	if position < m.state.offset {
+		m.state.offset = position
+	}
+
+	for m.state.offset < position && m.spanWidth(m.state.offset, position) > width {
+		m.state.offset++
+	}
+}
+
+func (m *Select) inlineWidth() int {
+	if m.width > 0 {
+		return m.width
+	}
+
+	return m.state.windowWidth
+}
+
+func (m *Select) spanWidth(start int, end int) int {
+	width := 0
+
+	if start > 0 {
+		width += lipgloss.Width(m.style.More.Render("←")) + 1
+	}
+
+	for i := start; i <= end; i++ {
+		if i > start {
+			width++
+		}
+
+		width += lipgloss.Width(m.items[m.state.visible[i]].Component.View())
+	}
+
+	if end < len(m.state.visible)-1 {
+		width += lipgloss.Width(m.style.More.Render("→")) + 1
+	}
+
+	return width
+}
+
+func (m *Select) inlineView() string {
+	var s string
+
+	width := m.inlineWidth()
+	start := min(m.state.offset, len(m.state.visible))
+	end := len(m.state.visible)
+
+	if width > 0 {
+		for end > start+1 && m.spanWidth(start, end-1) > width {
+			end--
+		}
+	}
+
+	if start > 0 {
+		s += m.style.More.Render("←") + " "
+	}
+
+	for i, index := range m.state.visible[start:end] {
+		if i > 0 {
+			s += " "
+		}
+
+		s += m.items[index].Component.View()
+	}
+
+	if end < len(m.state.visible) {
+		s += " " + m.style.More.Render("→")
+	}
+
+	return s
+}
+
+func (m *Select) updateFilter(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, m.keyMap.ClearFilter):
+		m.state.filter = ""
+	case msg.Type == tea.KeyBackspace:
+		_, size := utf8.DecodeLastRuneInString(m.state.filter)
+
+		m.state.filter = m.state.filter[:len(m.state.filter)-size]
+	case msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace:
+		m.state.filter += string(msg.Runes)
+	}
+}
+
+func (m *Select) filterItems() {
+	m.state.visible = []int{}
+	m.state.matches = map[int][]int{}
+
+	if m.state.filter == "" {
+		for i := range m.items {
+			m.state.visible = append(m.state.visible, i)
+		}
+
+		return
+	}
+
+	labels := make([]string, len(m.items))
+
+	for i, item := range m.items {
+		labels[i] = itemLabel(item)
+	}
+
+	for _, match := range fuzzy.Find(m.state.filter, labels) {
+		m.state.visible = append(m.state.visible, match.Index)
+		m.state.matches[match.Index] = runeIndexes(match.Str, match.MatchedIndexes)
+	}
+
+	if len(m.state.visible) > 0 && m.state.matches[m.state.selectedIndex] == nil {
+		m.state.selectedIndex = m.state.visible[0]
+	}
+}
+
+func (m *Select) updateTheme(theme component.Theme) tea.Cmd {
+	cmds := make([]tea.Cmd, len(m.items))
+
+	for i, item := range m.items {
+		m.items[i].Component, cmds[i] = item.Component.Update(component.ThemeMsg{Theme: theme})
+	}
+
+	return tea.Batch(cmds...)
+}
+
 func (m *Select) updateItems() tea.Cmd {
-	cmds := make([]tea.Cmd, len(m.items)*2)
+	cmds := make([]tea.Cmd, len(m.items)*3)
 
 	for i, item := range m.items {
 		var focusCmd tea.Cmd
 		var activeCmd tea.Cmd
+		var matchCmd tea.Cmd
 
 		item.Component, focusCmd = item.Component.Update(component.FocusMsg{Focus: i == m.state.selectedIndex && m.state.focus})
 		item.Component, activeCmd = item.Component.Update(component.ActiveMsg{Active: i == m.state.selectedIndex})
+		item.Component, matchCmd = item.Component.Update(component.MatchMsg{Indexes: m.state.matches[i]})
 
-		cmds[i*2] = focusCmd
-		cmds[i*2+1] = activeCmd
+		cmds[i*3] = focusCmd
+		cmds[i*3+1] = activeCmd
+		cmds[i*3+2] = matchCmd
 	}
 
 	return tea.Batch(cmds...)
 }
+
+func runeIndexes(s string, byteIndexes []int) []int {
+	indexes := make([]int, len(byteIndexes))
+
+	for i, byteIndex := range byteIndexes {
+		indexes[i] = utf8.RuneCountInString(s[:byteIndex])
+	}
+
+	return indexes
+}
+
+func itemLabel(item SelectItemProps) string {
+	if withLabel, ok := item.Component.(component.WithLabel); ok {
+		return withLabel.Label()
+	}
+
+	return item.Value
+}
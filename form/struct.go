@@ -0,0 +1,228 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/go-playground/validator/v10"
+)
+
+func FromStruct(v any) (*Form, error) {
+	rv := reflect.ValueOf(v)
+
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form source must be a non-nil pointer to a struct, got %T", v)
+	}
+
+	rv = rv.Elem()
+	rt := rv.Type()
+	validate := validator.New()
+	items := []FormItem{}
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := fieldName(field)
+
+		if name == "-" {
+			continue
+		}
+
+		if name == field.Name {
+			name = strings.ToLower(name)
+		}
+
+		options := parseTag(field.Tag.Get("boba"))
+		label := options["label"]
+
+		if label == "" {
+			label = field.Name
+		}
+
+		child, err := structComponent(field, rv.Field(i), name, options, validate)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		items = append(items, FormItem{
+			Name:      name,
+			Component: NewField(label, child),
+		})
+	}
+
+	m := NewForm(items)
+	m.target = v
+
+	return m, nil
+}
+
+func structComponent(field reflect.StructField, value reflect.Value, name string, options map[string]string, validate *validator.Validate) (component.Component, error) {
+	rules := field.Tag.Get("validate")
+
+	if choices, ok := options["options"]; ok {
+		props := structOptions(choices)
+
+		if len(props) == 0 {
+			return nil, errors.New("options must not be empty")
+		}
+
+		switch {
+		case field.Type.Kind() == reflect.String:
+			selected := NewSelect(props)
+
+			for i, prop := range props {
+				if prop.Value == value.String() {
+					selected.SetSelectedIndex(i)
+				}
+			}
+
+			return selected, nil
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.String:
+			multiSelect := NewMultiSelect(props).
+				SetValidateFunc(func(values []string) error {
+					return validateField(validate, name, values, rules)
+				})
+			multiSelect.SetValues(value.Convert(reflect.TypeOf([]string{})).Interface().([]string))
+
+			return multiSelect, nil
+		}
+
+		return nil, fmt.Errorf("options are not supported for %s", field.Type)
+	}
+
+	if field.Type.Kind() == reflect.Bool {
+		confirm := NewConfirm().
+			SetValue(value.Bool())
+
+		if affirmative := options["affirmative"]; affirmative != "" {
+			confirm.SetAffirmative(affirmative)
+		}
+
+		if negative := options["negative"]; negative != "" {
+			confirm.SetNegative(negative)
+		}
+
+		return confirm, nil
+	}
+
+	input := NewInput().
+		SetPlaceholder(options["placeholder"]).
+		SetValidateFunc(func(s string) error {
+			typed := reflect.New(field.Type).Elem()
+
+			if err := assignString(typed, s); err != nil {
+				return err
+			}
+
+			return validateField(validate, name, typed.Interface(), rules)
+		})
+
+	if !value.IsZero() {
+		input.SetValue(fmt.Sprint(structDefault(value)))
+	}
+
+	if _, ok := options["secret"]; ok {
+		input.SetSecret(true)
+	}
+
+	if mask := options["mask"]; mask != "" {
+		input.SetFormatMask(mask)
+	}
+
+	if suggestions := options["suggestions"]; suggestions != "" {
+		input.SetSuggestions(strings.Split(suggestions, "|"))
+	}
+
+	switch {
+	case field.Type == durationType:
+		return NewDurationValue(input), nil
+	case field.Type == timeType:
+		return NewTimeValue(input, time.RFC3339), nil
+	}
+
+	switch field.Type.Kind() {
+	case reflect.String:
+		return input, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return NewIntValue(input), nil
+	case reflect.Float32, reflect.Float64:
+		return NewFloatValue(input), nil
+	case reflect.Slice:
+		if field.Type.Elem().Kind() == reflect.String {
+			return NewStringsValue(input, ","), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", field.Type)
+}
+
+func structOptions(choices string) []SelectItemProps {
+	props := []SelectItemProps{}
+
+	for _, choice := range strings.Split(choices, "|") {
+		value, label, ok := strings.Cut(choice, ":")
+		value = strings.TrimSpace(value)
+
+		if !ok {
+			label = value
+		}
+
+		if value == "" {
+			continue
+		}
+
+		props = append(props, SelectItemProps{
+			Value:     value,
+			Component: component.NewOption(strings.TrimSpace(label)),
+		})
+	}
+
+	return props
+}
+
+func structDefault(value reflect.Value) any {
+	switch typed := value.Interface().(type) {
+	case time.Time:
+		return typed.Format(time.RFC3339)
+	case []string:
+		return strings.Join(typed, ", ")
+	}
+
+	return value.Interface()
+}
+
+func validateField(validate *validator.Validate, name string, value any, rules string) error {
+	if rules == "" {
+		return nil
+	}
+
+	err := validate.Var(value, rules)
+
+	var validationErrors validator.ValidationErrors
+
+	if errors.As(err, &validationErrors) && len(validationErrors) > 0 {
+		fieldError := validationErrors[0]
+
+		switch fieldError.Tag() {
+		case "required":
+			return fmt.Errorf("%s is required", name)
+		}
+
+		if fieldError.Param() != "" {
+			return fmt.Errorf("%s must satisfy %s=%s", name, fieldError.Tag(), fieldError.Param())
+		}
+
+		return fmt.Errorf("%s must be a valid %s", name, fieldError.Tag())
+	}
+
+	return err
+}
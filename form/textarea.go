@@ -0,0 +1,304 @@
+package form
+
+import (
+	"strings"
+
+	"github.com/MrSquaare/boba/component"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type TextAreaStyle struct {
+	TextBase         lipgloss.Style
+	TextFocus        lipgloss.Style
+	Error            lipgloss.Style
+	PromptBase       lipgloss.Style
+	PromptFocus      lipgloss.Style
+	PlaceholderBase  lipgloss.Style
+	PlaceholderFocus lipgloss.Style
+	Cursor           lipgloss.Style
+}
+
+type TextAreaState struct {
+	focus bool
+}
+
+type TextArea struct {
+	validateFunc func(string) error
+	err          error
+	theme        *component.Theme
+	input        textarea.Model
+	keyMap       TextAreaKeyMap
+	style        TextAreaStyle
+	overrides    map[string]bool
+	state        TextAreaState
+}
+
+type TextAreaKeyMap struct {
+	Newline key.Binding
+	Submit  key.Binding
+}
+
+var (
+	TextAreaDefaultStyle  = NewTextAreaStyle(component.DefaultTheme)
+	TextAreaDefaultKeyMap = TextAreaKeyMap{
+		Newline: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "New line"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("alt+enter", "ctrl+s"),
+			key.WithHelp("alt+enter/ctrl+s", "Submit text"),
+		),
+	}
+)
+
+func NewTextAreaStyle(theme component.Theme) TextAreaStyle {
+	return TextAreaStyle{
+		TextBase:         lipgloss.NewStyle().Foreground(theme.Foreground),
+		TextFocus:        lipgloss.NewStyle().Foreground(theme.Foreground),
+		PromptBase:       lipgloss.NewStyle().Foreground(theme.Foreground),
+		PromptFocus:      lipgloss.NewStyle().Foreground(theme.Foreground),
+		PlaceholderBase:  lipgloss.NewStyle().Foreground(theme.Muted),
+		PlaceholderFocus: lipgloss.NewStyle().Foreground(theme.Muted),
+		Cursor:           lipgloss.NewStyle().Foreground(theme.Accent),
+		Error:            lipgloss.NewStyle().Foreground(theme.Error),
+	}
+}
+
+func NewTextArea() *TextArea {
+	m := &TextArea{
+		validateFunc: func(string) error { return nil },
+		err:          nil,
+		theme:        nil,
+		input:        textarea.New(),
+		keyMap:       TextAreaDefaultKeyMap,
+		style:        TextAreaDefaultStyle,
+		overrides:    map[string]bool{},
+		state: TextAreaState{
+			focus: false,
+		},
+	}
+
+	m.input.ShowLineNumbers = false
+	m.input.KeyMap.InsertNewline = m.keyMap.Newline
+	m.updateStyle()
+
+	return m
+}
+
+func (m *TextArea) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+func (m *TextArea) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	cmds := []tea.Cmd{}
+
+	switch typedMsg := msg.(type) {
+	case component.FocusMsg:
+		m.state.focus = typedMsg.Focus
+		m.updateStyle()
+
+		if m.state.focus {
+			cmds = append(cmds, m.input.Focus())
+		} else {
+			m.input.Blur()
+		}
+	case component.ThemeMsg:
+		if m.theme == nil {
+			m.style = component.Restyle(m.style, NewTextAreaStyle(typedMsg.Theme), m.overrides)
+			m.updateStyle()
+		}
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(typedMsg, m.keyMap.Submit):
+			msg = nil
+
+			cmds = append(cmds, func() tea.Msg {
+				return NextMsg{}
+			})
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.input, cmd = m.input.Update(msg)
+
+	cmds = append(cmds, cmd)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *TextArea) View() string {
+	var s string
+
+	input := m.input
+
+	if component.NoColor() && !m.state.focus {
+		input.Prompt = strings.Repeat(" ", lipgloss.Width(input.Prompt))
+	}
+
+	s += input.View()
+
+	if m.err != nil {
+		s += "\n" + m.style.Error.Render(m.err.Error())
+	}
+
+	return s
+}
+
+func (m *TextArea) Captures() []key.Binding {
+	return []key.Binding{m.keyMap.Newline}
+}
+
+func (m *TextArea) Keys() []key.Binding {
+	return []key.Binding{m.keyMap.Newline, m.keyMap.Submit}
+}
+
+func (m *TextArea) Value() string {
+	return m.input.Value()
+}
+
+func (m *TextArea) Validate() bool {
+	m.err = m.validateFunc(m.input.Value())
+
+	return m.err == nil
+}
+
+func (m *TextArea) Error() error {
+	return m.err
+}
+
+func (m *TextArea) SetError(err error) {
+	m.err = err
+}
+
+func (m *TextArea) SetPlaceholder(placeholder string) *TextArea {
+	m.input.Placeholder = placeholder
+
+	return m
+}
+
+func (m *TextArea) SetValidateFunc(fn func(string) error) *TextArea {
+	m.validateFunc = fn
+
+	return m
+}
+
+func (m *TextArea) SetValue(value string) *TextArea {
+	m.input.SetValue(value)
+
+	return m
+}
+
+func (m *TextArea) SetWidth(width int) *TextArea {
+	m.input.SetWidth(width)
+
+	return m
+}
+
+func (m *TextArea) SetHeight(height int) *TextArea {
+	m.input.SetHeight(height)
+
+	return m
+}
+
+func (m *TextArea) SetCharLimit(limit int) *TextArea {
+	m.input.CharLimit = limit
+
+	return m
+}
+
+func (m *TextArea) SetShowLineNumbers(show bool) *TextArea {
+	m.input.ShowLineNumbers = show
+
+	return m
+}
+
+func (m *TextArea) SetKeyMap(keyMap TextAreaKeyMap) *TextArea {
+	m.keyMap = keyMap
+	m.input.KeyMap.InsertNewline = keyMap.Newline
+
+	return m
+}
+
+func (m *TextArea) SetTheme(theme component.Theme) *TextArea {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewTextAreaStyle(theme), m.overrides)
+	m.updateStyle()
+
+	return m
+}
+
+func (m *TextArea) SetTextBaseStyle(style lipgloss.Style) *TextArea {
+	m.style.TextBase = style
+	m.overrides["TextBase"] = true
+
+	return m
+}
+
+func (m *TextArea) SetTextFocusStyle(style lipgloss.Style) *TextArea {
+	m.style.TextFocus = style
+	m.overrides["TextFocus"] = true
+
+	return m
+}
+
+func (m *TextArea) SetPromptBaseStyle(style lipgloss.Style) *TextArea {
+	m.style.PromptBase = style
+	m.overrides["PromptBase"] = true
+
+	return m
+}
+
+func (m *TextArea) SetPromptFocusStyle(style lipgloss.Style) *TextArea {
+	m.style.PromptFocus = style
+	m.overrides["PromptFocus"] = true
+
+	return m
+}
+
+func (m *TextArea) SetPlaceholderBaseStyle(style lipgloss.Style) *TextArea {
+	m.style.PlaceholderBase = style
+	m.overrides["PlaceholderBase"] = true
+
+	return m
+}
+
+func (m *TextArea) SetPlaceholderFocusStyle(style lipgloss.Style) *TextArea {
+	m.style.PlaceholderFocus = style
+	m.overrides["PlaceholderFocus"] = true
+
+	return m
+}
+
+func (m *TextArea) SetCursorStyle(style lipgloss.Style) *TextArea {
+	m.style.Cursor = style
+	m.overrides["Cursor"] = true
+
+	return m
+}
+
+func (m *TextArea) SetErrorStyle(style lipgloss.Style) *TextArea {
+	m.style.Error = style
+	m.overrides["Error"] = true
+
+	return m
+}
+
+func (m *TextArea) updateStyle() {
+	if m.state.focus {
+		m.input.FocusedStyle.Text = m.style.TextFocus
+		m.input.FocusedStyle.CursorLine = m.style.TextFocus
+		m.input.FocusedStyle.Prompt = m.style.PromptFocus
+		m.input.FocusedStyle.Placeholder = m.style.PlaceholderFocus
+	} else {
+		m.input.BlurredStyle.Text = m.style.TextBase
+		m.input.BlurredStyle.CursorLine = m.style.TextBase
+		m.input.BlurredStyle.Prompt = m.style.PromptBase
+		m.input.BlurredStyle.Placeholder = m.style.PlaceholderBase
+	}
+	m.input.Cursor.Style = m.style.Cursor
+}
@@ -0,0 +1,256 @@
+package form
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MrSquaare/boba/component"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type ValueStyle struct {
+	Error lipgloss.Style
+}
+
+type Value[T any] struct {
+	child     component.Component
+	parse     func(string) (T, error)
+	err       error
+	theme     *component.Theme
+	style     ValueStyle
+	overrides map[string]bool
+}
+
+var (
+	ValueDefaultStyle = NewValueStyle(component.DefaultTheme)
+)
+
+func NewValueStyle(theme component.Theme) ValueStyle {
+	return ValueStyle{
+		Error: lipgloss.NewStyle().Foreground(theme.Error),
+	}
+}
+
+func NewValue[T any](child component.Component, parse func(string) (T, error)) *Value[T] {
+	m := &Value[T]{
+		child:     child,
+		parse:     parse,
+		err:       nil,
+		theme:     nil,
+		style:     ValueDefaultStyle,
+		overrides: map[string]bool{},
+	}
+
+	return m
+}
+
+func NewIntValue(child component.Component) *Value[int] {
+	return NewValue(child, parseInt)
+}
+
+func NewFloatValue(child component.Component) *Value[float64] {
+	return NewValue(child, parseFloat)
+}
+
+func NewBoolValue(child component.Component) *Value[bool] {
+	return NewValue(child, parseBool)
+}
+
+func NewDurationValue(child component.Component) *Value[time.Duration] {
+	return NewValue(child, parseDuration)
+}
+
+func NewTimeValue(child component.Component, layout string) *Value[time.Time] {
+	return NewValue(child, func(s string) (time.Time, error) {
+		return parseTime(s, layout)
+	})
+}
+
+func NewStringsValue(child component.Component, sep string) *Value[[]string] {
+	return NewValue(child, func(s string) ([]string, error) {
+		return parseStrings(s, sep), nil
+	})
+}
+
+func (m *Value[T]) Init() tea.Cmd {
+	return m.child.Init()
+}
+
+func (m *Value[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if typedMsg, ok := msg.(component.ThemeMsg); ok {
+		if m.theme != nil {
+			return m, nil
+		}
+
+		m.style = component.Restyle(m.style, NewValueStyle(typedMsg.Theme), m.overrides)
+	}
+
+	var cmd tea.Cmd
+
+	m.child, cmd = m.child.Update(msg)
+
+	return m, cmd
+}
+
+func (m *Value[T]) View() string {
+	var s string
+
+	s += m.child.View()
+
+	if m.err != nil {
+		s += "\n" + m.style.Error.Render(m.err.Error())
+	}
+
+	return s
+}
+
+func (m *Value[T]) Child() component.Component {
+	return m.child
+}
+
+func (m *Value[T]) TypedValue() (any, error) {
+	return m.Parsed()
+}
+
+func (m *Value[T]) Parsed() (T, error) {
+	var raw string
+
+	if withValue, ok := withValue(m.child); ok {
+		raw = withValue.Value()
+	}
+
+	return m.parse(raw)
+}
+
+func (m *Value[T]) Validate() bool {
+	m.err = nil
+
+	if withValidation, ok := withValidation(m.child); ok {
+		if !withValidation.Validate() {
+			return false
+		}
+	}
+
+	_, m.err = m.Parsed()
+
+	return m.err == nil
+}
+
+func (m *Value[T]) Error() error {
+	if m.err != nil {
+		return m.err
+	}
+
+	if withValidation, ok := withValidation(m.child); ok {
+		return withValidation.Error()
+	}
+
+	return nil
+}
+
+func (m *Value[T]) SetError(err error) {
+	m.err = err
+}
+
+func (m *Value[T]) SetTheme(theme component.Theme) *Value[T] {
+	m.theme = &theme
+	m.style = component.Restyle(m.style, NewValueStyle(theme), m.overrides)
+	m.child, _ = m.child.Update(component.ThemeMsg{Theme: theme})
+
+	return m
+}
+
+func (m *Value[T]) SetErrorStyle(style lipgloss.Style) *Value[T] {
+	m.style.Error = style
+	m.overrides["Error"] = true
+
+	return m
+}
+
+func parseInt(s string) (int, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid integer", s)
+	}
+
+	return v, nil
+}
+
+func parseFloat(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid number", s)
+	}
+
+	return v, nil
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "0", "f", "false", "n", "no", "off":
+		return false, nil
+	case "1", "t", "true", "y", "yes", "on":
+		return true, nil
+	}
+
+	return false, fmt.Errorf("%q is not a valid boolean", s)
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid duration", s)
+	}
+
+	return v, nil
+}
+
+func parseTime(s string, layout string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	v, err := time.Parse(layout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q does not match the layout %q", s, layout)
+	}
+
+	return v, nil
+}
+
+func parseStrings(s string, sep string) []string {
+	values := []string{}
+
+	for _, value := range strings.Split(s, sep) {
+		value = strings.TrimSpace(value)
+
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
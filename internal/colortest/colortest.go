@@ -0,0 +1,57 @@
+package colortest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+var Profiles = []struct {
+	Name    string
+	Profile termenv.Profile
+}{
+	{"ascii", termenv.Ascii},
+	{"ansi", termenv.ANSI},
+	{"ansi256", termenv.ANSI256},
+	{"truecolor", termenv.TrueColor},
+}
+
+func SetProfile(t *testing.T, profile termenv.Profile, dark bool) {
+	t.Helper()
+
+	previousProfile := lipgloss.ColorProfile()
+	previousDark := lipgloss.HasDarkBackground()
+
+	lipgloss.SetColorProfile(profile)
+	lipgloss.SetHasDarkBackground(dark)
+
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(previousProfile)
+		lipgloss.SetHasDarkBackground(previousDark)
+	})
+}
+
+func CheckProfile(t *testing.T, profile termenv.Profile, view string) {
+	t.Helper()
+
+	switch profile {
+	case termenv.Ascii:
+		if strings.Contains(view, "\x1b[") {
+			t.Errorf("view contains escape sequences under ascii profile: %q", view)
+		}
+	case termenv.ANSI:
+		if strings.Contains(view, "8;5;") || strings.Contains(view, "8;2;") {
+			t.Errorf("view contains extended colours under ansi profile: %q", view)
+		}
+	case termenv.ANSI256:
+		if strings.Contains(view, "8;2;") {
+			t.Errorf("view contains true colours under ansi256 profile: %q", view)
+		}
+	}
+
+	if profile != termenv.Ascii && !strings.Contains(view, "\x1b[") {
+		t.Errorf("view contains no escape sequences under colour profile: %q", view)
+	}
+}